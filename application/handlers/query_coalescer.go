@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// ContextKeyFunc returns the part of the coalescing key that comes from the context,
+// the context values that change the result of a query, like the request language, must be part of it
+type ContextKeyFunc func(ctx context.Context) string
+
+type DecoratorOption func(options *decoratorOptions)
+
+type decoratorOptions struct {
+	coalesce   bool
+	contextKey ContextKeyFunc
+}
+
+// WithCoalescing lets concurrent calls with the same query share one call of the handler,
+// the shared result must not be modified by the callers
+func WithCoalescing(contextKey ContextKeyFunc) DecoratorOption {
+	return func(options *decoratorOptions) {
+		options.coalesce = true
+		options.contextKey = contextKey
+	}
+}
+
+type queryCoalescer[R any] struct {
+	contextKey ContextKeyFunc
+	mutex      sync.Mutex
+	calls      map[string]*coalescedCall[R]
+}
+
+type coalescedCall[R any] struct {
+	done    chan struct{}
+	result  R
+	err     error
+	waiters int
+	cancel  context.CancelFunc
+}
+
+func newQueryCoalescer[R any](contextKey ContextKeyFunc) *queryCoalescer[R] {
+	return &queryCoalescer[R]{
+		contextKey: contextKey,
+		calls:      make(map[string]*coalescedCall[R]),
+	}
+}
+
+// do runs handle once for the callers of the same key, the shared call is not cancelled by a single caller,
+// it is cancelled only when every caller has gone
+func (coalescer *queryCoalescer[R]) do(ctx context.Context, value any, handle func(ctx context.Context) (R, error)) (R, error) {
+	key, err := coalescer.key(ctx, value)
+	if err != nil {
+		return handle(ctx)
+	}
+	coalescer.mutex.Lock()
+	call, exists := coalescer.calls[key]
+	if !exists {
+		callCtx, cancel := callContext(ctx)
+		call = &coalescedCall[R]{done: make(chan struct{}), cancel: cancel}
+		coalescer.calls[key] = call
+		go coalescer.run(callCtx, key, call, handle)
+	}
+	call.waiters++
+	coalescer.mutex.Unlock()
+
+	select {
+	case <-call.done:
+		return call.result, call.err
+	case <-ctx.Done():
+		coalescer.mutex.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			// a later caller must not join the cancelled call
+			coalescer.forget(key, call)
+			call.cancel()
+		}
+		coalescer.mutex.Unlock()
+		var result R
+		return result, ctx.Err()
+	}
+}
+
+// callContext keeps the values and the deadline of the first caller's ctx but not its cancellation,
+// so the call is still bounded when that caller leaves and later callers wait for it
+func callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	callCtx := context.WithoutCancel(ctx)
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline {
+		return context.WithDeadline(callCtx, deadline)
+	}
+	return context.WithCancel(callCtx)
+}
+
+// run calls handle in its own goroutine, a panic is returned to the callers as the error of the call
+// since nothing up the goroutine's stack would recover it
+func (coalescer *queryCoalescer[R]) run(ctx context.Context, key string, call *coalescedCall[R], handle func(ctx context.Context) (R, error)) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			call.err = fmt.Errorf("query handler panic: %v", recovered)
+		}
+		call.cancel()
+		coalescer.mutex.Lock()
+		coalescer.forget(key, call)
+		coalescer.mutex.Unlock()
+		close(call.done)
+	}()
+	call.result, call.err = handle(ctx)
+}
+
+// forget removes the call of key when it is still the running one, the mutex must be held
+func (coalescer *queryCoalescer[R]) forget(key string, call *coalescedCall[R]) {
+	if coalescer.calls[key] == call {
+		delete(coalescer.calls, key)
+	}
+}
+
+func (coalescer *queryCoalescer[R]) key(ctx context.Context, value any) (string, error) {
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	if coalescer.contextKey == nil {
+		return string(valueBytes), nil
+	}
+	return coalescer.contextKey(ctx) + "|" + string(valueBytes), nil
+}
@@ -0,0 +1,244 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testQuery struct {
+	Id int64
+}
+
+// blockingHandler counts its calls and blocks every call until release is closed or its ctx ends
+type blockingHandler struct {
+	calls   atomic.Int32
+	started chan context.Context
+	release chan struct{}
+}
+
+func newBlockingHandler() *blockingHandler {
+	return &blockingHandler{started: make(chan context.Context, 10), release: make(chan struct{})}
+}
+
+func (handler *blockingHandler) handle(ctx context.Context) (string, error) {
+	call := handler.calls.Add(1)
+	handler.started <- ctx
+	select {
+	case <-handler.release:
+		return strings.Repeat("result", int(call)), nil
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+func waitFor(t *testing.T, done <-chan struct{}, what string) {
+	t.Helper()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for %s", what)
+	}
+}
+
+func TestQueryCoalescer_SharesOneCall(t *testing.T) {
+	coalescer := newQueryCoalescer[string](nil)
+	handler := newBlockingHandler()
+	const callers = 5
+	results := make(chan string, callers)
+	var wait sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			result, err := coalescer.do(context.Background(), testQuery{Id: 1}, handler.handle)
+			if err != nil {
+				t.Error(err)
+			}
+			results <- result
+		}()
+	}
+	<-handler.started
+	for waiterCount(t, coalescer, testQuery{Id: 1}) < callers {
+		time.Sleep(time.Millisecond)
+	}
+	close(handler.release)
+	wait.Wait()
+	close(results)
+
+	if calls := handler.calls.Load(); calls != 1 {
+		t.Fatalf("handler was called %d times, want 1", calls)
+	}
+	for result := range results {
+		if result != "result" {
+			t.Fatalf("a caller got %q", result)
+		}
+	}
+}
+
+func TestQueryCoalescer_LastWaiterLeavingCancelsTheCall(t *testing.T) {
+	coalescer := newQueryCoalescer[string](nil)
+	handler := newBlockingHandler()
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	secondCtx, cancelSecond := context.WithCancel(context.Background())
+	errs := make(chan error, 2)
+	go func() {
+		_, err := coalescer.do(firstCtx, testQuery{Id: 1}, handler.handle)
+		errs <- err
+	}()
+	callCtx := <-handler.started
+	go func() {
+		_, err := coalescer.do(secondCtx, testQuery{Id: 1}, handler.handle)
+		errs <- err
+	}()
+	for waiterCount(t, coalescer, testQuery{Id: 1}) < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	cancelFirst()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("the leaving caller got %v, want context.Canceled", err)
+	}
+	select {
+	case <-callCtx.Done():
+		t.Fatal("the call was cancelled while a caller still waits for it")
+	case <-time.After(10 * time.Millisecond):
+	}
+	cancelSecond()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Fatalf("the last caller got %v, want context.Canceled", err)
+	}
+	waitFor(t, callCtx.Done(), "the call to be cancelled after the last caller left")
+}
+
+func TestQueryCoalescer_LateJoinerAfterCancelStartsANewCall(t *testing.T) {
+	coalescer := newQueryCoalescer[string](nil)
+	handler := newBlockingHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		_, err := coalescer.do(ctx, testQuery{Id: 1}, handler.handle)
+		errs <- err
+	}()
+	cancelledCallCtx := <-handler.started
+	cancel()
+	<-errs
+	waitFor(t, cancelledCallCtx.Done(), "the call to be cancelled")
+
+	results := make(chan string, 1)
+	go func() {
+		result, err := coalescer.do(context.Background(), testQuery{Id: 1}, handler.handle)
+		if err != nil {
+			t.Error(err)
+		}
+		results <- result
+	}()
+	if newCallCtx := <-handler.started; newCallCtx.Err() != nil {
+		t.Fatal("the late caller joined the cancelled call")
+	}
+	close(handler.release)
+	if result := <-results; result != "resultresult" {
+		t.Fatalf("the late caller got %q, want the result of a second call", result)
+	}
+}
+
+func TestQueryCoalescer_KeepsTheDeadline(t *testing.T) {
+	coalescer := newQueryCoalescer[string](nil)
+	handler := newBlockingHandler()
+	deadline := time.Now().Add(time.Hour)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+	go func() {
+		_, _ = coalescer.do(ctx, testQuery{Id: 1}, handler.handle)
+	}()
+	callCtx := <-handler.started
+	defer close(handler.release)
+
+	if callDeadline, hasDeadline := callCtx.Deadline(); !hasDeadline || !callDeadline.Equal(deadline) {
+		t.Fatalf("the call deadline is %v, %v, want %v", callDeadline, hasDeadline, deadline)
+	}
+}
+
+func TestQueryCoalescer_PanicIsAnErrorForAllWaiters(t *testing.T) {
+	coalescer := newQueryCoalescer[string](nil)
+	release := make(chan struct{})
+	started := make(chan struct{})
+	handle := func(ctx context.Context) (string, error) {
+		close(started)
+		<-release
+		panic("boom")
+	}
+	const callers = 3
+	errs := make(chan error, callers)
+	for i := 0; i < callers; i++ {
+		go func() {
+			_, err := coalescer.do(context.Background(), testQuery{Id: 1}, handle)
+			errs <- err
+		}()
+	}
+	<-started
+	for waiterCount(t, coalescer, testQuery{Id: 1}) < callers {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+
+	for i := 0; i < callers; i++ {
+		if err := <-errs; err == nil || !strings.Contains(err.Error(), "boom") {
+			t.Fatalf("a caller got %v, want the panic as an error", err)
+		}
+	}
+}
+
+func TestQueryCoalescer_KeyIncludesTheLocale(t *testing.T) {
+	coalescer := newQueryCoalescer[string](locale.ContextKey)
+	english := locale.WithLanguages(context.Background(), []string{"en", "tr"})
+	turkish := locale.WithLanguages(context.Background(), []string{"tr"})
+
+	englishKey, err := coalescer.key(english, testQuery{Id: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	turkishKey, err := coalescer.key(turkish, testQuery{Id: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if englishKey == turkishKey {
+		t.Fatalf("the same query in different languages has the same key %q", englishKey)
+	}
+
+	handler := newBlockingHandler()
+	go func() {
+		_, _ = coalescer.do(english, testQuery{Id: 1}, handler.handle)
+	}()
+	go func() {
+		_, _ = coalescer.do(turkish, testQuery{Id: 1}, handler.handle)
+	}()
+	defer close(handler.release)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-handler.started:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("handler was called %d times, want one call per language", handler.calls.Load())
+		}
+	}
+}
+
+// waiterCount returns the waiter count of the running call of value
+func waiterCount[R any](t *testing.T, coalescer *queryCoalescer[R], value any) int {
+	t.Helper()
+	key, err := coalescer.key(context.Background(), value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	coalescer.mutex.Lock()
+	defer coalescer.mutex.Unlock()
+	if call, exists := coalescer.calls[key]; exists {
+		return call.waiters
+	}
+	return 0
+}
@@ -6,6 +6,22 @@ import (
 )
 
 type QueryHandler struct {
-	GetAdvert   QueryHandlerDecorator[*queries.GetAdvertQuery, *model_api.AdvertResponse]
-	GetCategory QueryHandlerDecorator[*queries.GetCategoryQuery, *model_api.CategoryResponse]
+	AdvertExists         QueryHandlerDecorator[*queries.AdvertExistsQuery, bool]
+	GetAdvertFull        QueryHandlerDecorator[*queries.GetAdvertQuery, *model_api.AdvertFullResponse]
+	GetAdvert            QueryHandlerDecorator[*queries.GetAdvertQuery, *model_api.AdvertResponse]
+	GetAdverts           QueryHandlerDecorator[*queries.GetAdvertsQuery, *model_api.AdvertListResponse]
+	CountAdverts         QueryHandlerDecorator[*queries.CountAdvertsQuery, *model_api.AdvertCountResponse]
+	GetAdvertChanges     QueryHandlerDecorator[*queries.GetAdvertChangesQuery, *model_api.AdvertChangesResponse]
+	ExportAdverts        QueryHandlerDecorator[*queries.ExportAdvertsQuery, int64]
+	GetSimilarAdverts    QueryHandlerDecorator[*queries.GetSimilarAdvertsQuery, *model_api.SimilarAdvertsResponse]
+	SearchAdverts        QueryHandlerDecorator[*queries.SearchAdvertsQuery, *model_api.AdvertSearchResponse]
+	Suggest              QueryHandlerDecorator[*queries.SuggestQuery, *model_api.SuggestResponse]
+	CategoryExists       QueryHandlerDecorator[*queries.CategoryExistsQuery, bool]
+	GetCategoryFull      QueryHandlerDecorator[*queries.GetCategoryQuery, *model_api.CategoryFullResponse]
+	GetCategory          QueryHandlerDecorator[*queries.GetCategoryQuery, *model_api.CategoryResponse]
+	ListCategories       QueryHandlerDecorator[*queries.ListCategoriesQuery, *model_api.CategorySearchResponse]
+	GetCategories        QueryHandlerDecorator[*queries.GetCategoriesQuery, *model_api.CategoryListResponse]
+	GetCategoryTree      QueryHandlerDecorator[*queries.GetCategoryTreeQuery, *model_api.CategoryTreeResponse]
+	GetCategoryAncestors QueryHandlerDecorator[*queries.GetCategoryAncestorsQuery, *model_api.CategoryAncestorsResponse]
+	GetCategoryAdverts   QueryHandlerDecorator[*queries.GetCategoryAdvertsQuery, *model_api.CategoryAdvertsResponse]
 }
@@ -15,15 +15,24 @@ type QueryHandlerDecorator[T any, R any] interface {
 }
 
 type queryHandlerDecorator[T any, R any] struct {
-	tracers []tracers.Tracer
-	handler QueryHandlerInterface[T, R]
+	tracers   []tracers.Tracer
+	handler   QueryHandlerInterface[T, R]
+	coalescer *queryCoalescer[R]
 }
 
-func NewQueryHandlerDecorator[T any, R any](handler QueryHandlerInterface[T, R], tracers []tracers.Tracer) QueryHandlerDecorator[T, R] {
-	return &queryHandlerDecorator[T, R]{
+func NewQueryHandlerDecorator[T any, R any](handler QueryHandlerInterface[T, R], tracers []tracers.Tracer, options ...DecoratorOption) QueryHandlerDecorator[T, R] {
+	decoratorOptions := &decoratorOptions{}
+	for _, option := range options {
+		option(decoratorOptions)
+	}
+	decorator := &queryHandlerDecorator[T, R]{
 		tracers: tracers,
 		handler: handler,
 	}
+	if decoratorOptions.coalesce {
+		decorator.coalescer = newQueryCoalescer[R](decoratorOptions.contextKey)
+	}
+	return decorator
 }
 
 func (decorator *queryHandlerDecorator[T, R]) Handle(ctx context.Context, value T) (R, error) {
@@ -33,7 +42,15 @@ func (decorator *queryHandlerDecorator[T, R]) Handle(ctx context.Context, value
 		ctx, deferFunction = decoratorTracer.Trace(ctx, reflect.TypeOf(decorator.handler).String(), "Handle")
 		deferFunctions = append(deferFunctions, deferFunction)
 	}
-	result, err := decorator.handler.Handle(ctx, value)
+	var result R
+	var err error
+	if decorator.coalescer != nil {
+		result, err = decorator.coalescer.do(ctx, value, func(ctx context.Context) (R, error) {
+			return decorator.handler.Handle(ctx, value)
+		})
+	} else {
+		result, err = decorator.handler.Handle(ctx, value)
+	}
 	for _, function := range deferFunctions {
 		function()
 	}
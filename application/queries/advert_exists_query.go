@@ -0,0 +1,5 @@
+package queries
+
+type AdvertExistsQuery struct {
+	Id int64 `json:"id"`
+}
@@ -0,0 +1,5 @@
+package queries
+
+type CategoryExistsQuery struct {
+	Id int64 `json:"id"`
+}
@@ -0,0 +1,6 @@
+package queries
+
+type CountAdvertsQuery struct {
+	AdvertFilter
+	Exact bool `json:"exact"`
+}
@@ -0,0 +1,9 @@
+package queries
+
+import "presentation-advert-read-api/model/model_api"
+
+type ExportAdvertsQuery struct {
+	CategoryId int64 `json:"categoryId"`
+	// Write is called for every scrolled batch, returning an error stops the export
+	Write func(adverts []*model_api.AdvertResponse) error `json:"-"`
+}
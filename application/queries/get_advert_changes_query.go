@@ -0,0 +1,7 @@
+package queries
+
+type GetAdvertChangesQuery struct {
+	Since      string `json:"since"`
+	Checkpoint string `json:"checkpoint"`
+	Size       int    `json:"size"`
+}
@@ -0,0 +1,6 @@
+package queries
+
+type GetAdvertsQuery struct {
+	Ids    []int64  `json:"ids"`
+	Fields []string `json:"fields"`
+}
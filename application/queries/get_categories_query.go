@@ -0,0 +1,6 @@
+package queries
+
+type GetCategoriesQuery struct {
+	Ids    []int64  `json:"ids"`
+	Fields []string `json:"fields"`
+}
@@ -0,0 +1,8 @@
+package queries
+
+type GetCategoryAdvertsQuery struct {
+	CategoryId int64    `json:"categoryId"`
+	Cursor     string   `json:"cursor"`
+	Size       int      `json:"size"`
+	Fields     []string `json:"fields"`
+}
@@ -0,0 +1,5 @@
+package queries
+
+type GetCategoryAncestorsQuery struct {
+	Id int64 `json:"id"`
+}
@@ -1,5 +1,6 @@
 package queries
 
 type GetCategoryQuery struct {
-	Id int64 `json:"id"`
+	Id     int64    `json:"id"`
+	Fields []string `json:"fields"`
 }
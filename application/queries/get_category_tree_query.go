@@ -0,0 +1,5 @@
+package queries
+
+type GetCategoryTreeQuery struct {
+	Id int64 `json:"id"`
+}
@@ -0,0 +1,6 @@
+package queries
+
+type GetSimilarAdvertsQuery struct {
+	Id   int64 `json:"id"`
+	Size int   `json:"size"`
+}
@@ -0,0 +1,11 @@
+package queries
+
+type ListCategoriesQuery struct {
+	NamePrefix string        `json:"prefix"`
+	Ranges     []RangeFilter `json:"ranges"`
+	Sort       string        `json:"sort"`
+	Descending bool          `json:"descending"`
+	Page       int           `json:"page"`
+	Size       int           `json:"size"`
+	Fields     []string      `json:"fields"`
+}
@@ -0,0 +1,8 @@
+package queries
+
+// RangeFilter is a field[operator]=value parameter, operator is one of gte, gt, lte and lt
+type RangeFilter struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
@@ -0,0 +1,32 @@
+package queries
+
+// AdvertFilter is shared by SearchAdvertsQuery and CountAdvertsQuery
+type AdvertFilter struct {
+	Query            string        `json:"q"`
+	CategoryId       int64         `json:"categoryId"`
+	CreationDateFrom string        `json:"creationDateFrom"`
+	CreationDateTo   string        `json:"creationDateTo"`
+	Ranges           []RangeFilter `json:"ranges"`
+	Near             *Near         `json:"near"`
+}
+
+// Near filters adverts within Radius kilometres of Lat and Lon, a zero Radius only calculates distances
+type Near struct {
+	Lat    float64 `json:"lat"`
+	Lon    float64 `json:"lon"`
+	Radius float64 `json:"radius"`
+}
+
+const (
+	SortByRelevance = "relevance"
+	SortByDistance  = "distance"
+)
+
+type SearchAdvertsQuery struct {
+	AdvertFilter
+	Facets []string `json:"facets"`
+	Sort   string   `json:"sort"`
+	Page   int      `json:"page"`
+	Size   int      `json:"size"`
+	Fields []string `json:"fields"`
+}
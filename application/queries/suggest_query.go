@@ -0,0 +1,6 @@
+package queries
+
+type SuggestQuery struct {
+	Prefix string `json:"prefix"`
+	Size   int    `json:"size"`
+}
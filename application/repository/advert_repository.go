@@ -7,5 +7,14 @@ import (
 
 type AdvertRepository interface {
 	Save(ctx context.Context, model *model_repository.Advert) error
-	GetById(ctx context.Context, id int64) (*model_repository.Advert, error)
+	GetById(ctx context.Context, id int64, fields ...string) (*model_repository.Advert, error)
+	ExistsById(ctx context.Context, id int64) (bool, error)
+	GetByIds(ctx context.Context, ids []int64, fields ...string) ([]*model_repository.Advert, []int64, error)
+	GetByCategoryId(ctx context.Context, categoryId int64, cursor string, size int, fields ...string) (*model_repository.AdvertCursorResult, error)
+	GetSimilar(ctx context.Context, advert *model_repository.Advert, size int) ([]*model_repository.Advert, error)
+	SuggestTitles(ctx context.Context, prefix string, size int) ([]*model_repository.Suggestion, error)
+	GetChanges(ctx context.Context, since string, checkpoint string, size int) (*model_repository.AdvertChangeResult, error)
+	ScrollByCategoryId(ctx context.Context, categoryId int64, consume func(adverts []*model_repository.Advert) error) error
+	Count(ctx context.Context, filter *model_repository.AdvertFilter, exact bool) (*model_repository.AdvertCount, error)
+	Search(ctx context.Context, criteria *model_repository.AdvertSearchCriteria) (*model_repository.AdvertSearchResult, error)
 }
@@ -7,5 +7,11 @@ import (
 
 type CategoryRepository interface {
 	Save(ctx context.Context, model *model_repository.Category) error
-	GetById(ctx context.Context, id int64) (*model_repository.Category, error)
+	GetById(ctx context.Context, id int64, fields ...string) (*model_repository.Category, error)
+	ExistsById(ctx context.Context, id int64) (bool, error)
+	GetByIds(ctx context.Context, ids []int64, fields ...string) ([]*model_repository.Category, []int64, error)
+	GetAncestors(ctx context.Context, id int64) ([]*model_repository.Category, error)
+	GetSubtree(ctx context.Context, id int64) ([]*model_repository.Category, error)
+	SuggestNames(ctx context.Context, prefix string, size int) ([]*model_repository.Suggestion, error)
+	Search(ctx context.Context, criteria *model_repository.CategorySearchCriteria) (*model_repository.CategorySearchResult, error)
 }
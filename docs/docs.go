@@ -16,6 +16,299 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/adverts": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "adverts"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comma separated advert ids, when given adverts are fetched by id and model_api.AdvertListResponse is returned",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "text searched in title and description",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "category id",
+                        "name": "categoryId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "inclusive creation date lower bound, yyyy-MM-dd or RFC 3339",
+                        "name": "creationDateFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "inclusive creation date upper bound, yyyy-MM-dd or RFC 3339",
+                        "name": "creationDateTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "range filter, gte, gt, lte and lt operators are supported on creationDate, lastModifiedDate and version",
+                        "name": "creationDate[gte]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "latitude of the origin, required with lon",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "longitude of the origin, required with lat",
+                        "name": "lon",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "keeps adverts within radius kilometres of the origin",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "relevance (default) or distance, distance needs lat and lon",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated facets to compute, category and creationMonth are supported",
+                        "name": "facets",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, id is always returned",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "language tag of the category names like en or en-US, takes precedence over Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred languages of the category names",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, starts from 0, (page+1)*size must be at most 10000",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size, max 100",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_api.AdvertSearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            }
+        },
+        "/adverts/_count": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "adverts"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "text searched in title and description",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "category id",
+                        "name": "categoryId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "inclusive creation date lower bound, yyyy-MM-dd or RFC 3339",
+                        "name": "creationDateFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "inclusive creation date upper bound, yyyy-MM-dd or RFC 3339",
+                        "name": "creationDateTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "range filter, gte, gt, lte and lt operators are supported on creationDate, lastModifiedDate and version",
+                        "name": "creationDate[gte]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "latitude of the origin, required with lon",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "longitude of the origin, required with lat",
+                        "name": "lon",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "keeps adverts within radius kilometres of the origin",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "counts exactly when true (default), otherwise a lower bound may be returned for large counts",
+                        "name": "exact",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_api.AdvertCountResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            }
+        },
+        "/adverts/_export": {
+            "get": {
+                "produces": [
+                    "application/x-ndjson"
+                ],
+                "tags": [
+                    "adverts"
+                ],
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "category id, all adverts are exported when it is not given",
+                        "name": "categoryId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "one advert per line, an export failing after its first line ends with a model_api.AdvertExportErrorResponse line",
+                        "schema": {
+                            "$ref": "#/definitions/model_api.AdvertResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            }
+        },
+        "/adverts/changes": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "adverts"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "changes modified at or after this date are returned, yyyy-MM-dd or RFC 3339, changes of the last 30 seconds are returned by a later call",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "checkpoint returned by the previous call, the feed continues after it",
+                        "name": "checkpoint",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size, max 100",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_api.AdvertChangesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            }
+        },
         "/adverts/{id}": {
             "get": {
                 "consumes": [
@@ -25,7 +318,368 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "adverts"
+                    "adverts"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, id is always returned",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "language tag of the category names like en or en-US, takes precedence over Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred languages of the category names",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default or full, full adds version and audit fields",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "view=full is the same as ?view=full",
+                        "name": "Prefer",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the cached response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "last modified date of the cached response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_api.AdvertResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            },
+            "head": {
+                "tags": [
+                    "adverts"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Advert exists"
+                    },
+                    "400": {
+                        "description": "id is not a number"
+                    },
+                    "404": {
+                        "description": "Advert does not exist"
+                    }
+                }
+            }
+        },
+        "/adverts/{id}/similar": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "adverts"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "similar advert count, max 20",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_api.SimilarAdvertsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comma separated category ids, when given categories are fetched by id and model_api.CategoryListResponse is returned",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name prefix, matched against the default name whatever the language",
+                        "name": "prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "range filter, gte, gt, lte and lt operators are supported on creationDate, lastModifiedDate, version and depth",
+                        "name": "depth[lte]",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sort field, id or name, name sorts by the default name whatever the language",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "sort order, asc or desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, id is always returned",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "language tag of the category names like en or en-US, takes precedence over Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred languages of the category names",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page number, starts from 0, (page+1)*size must be at most 10000",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size, max 100",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_api.CategorySearchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, id is always returned",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "language tag of the category names like en or en-US, takes precedence over Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred languages of the category names",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "default or full, full adds version and audit fields",
+                        "name": "view",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "view=full is the same as ?view=full",
+                        "name": "Prefer",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "etag of the cached response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "last modified date of the cached response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_api.CategoryResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            },
+            "head": {
+                "tags": [
+                    "categories"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category exists"
+                    },
+                    "400": {
+                        "description": "id is not a number"
+                    },
+                    "404": {
+                        "description": "Category does not exist"
+                    }
+                }
+            }
+        },
+        "/categories/{id}/adverts": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
                 ],
                 "parameters": [
                     {
@@ -34,13 +688,79 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "next cursor returned by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size, max 100",
+                        "name": "size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields to return, id is always returned",
+                        "name": "fields",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "language tag of the category names like en or en-US, takes precedence over Accept-Language",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "preferred languages of the category names",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_api.AdvertResponse"
+                            "$ref": "#/definitions/model_api.CategoryAdvertsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{id}/ancestors": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_api.CategoryAncestorsResponse"
                         }
                     },
                     "400": {
@@ -58,7 +778,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/categories/{id}": {
+        "/categories/{id}/tree": {
             "get": {
                 "consumes": [
                     "application/json"
@@ -82,7 +802,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model_api.CategoryResponse"
+                            "$ref": "#/definitions/model_api.CategoryTreeResponse"
                         }
                     },
                     "400": {
@@ -99,6 +819,86 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "/graphql": {
+            "post": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "parameters": [
+                    {
+                        "description": "graphql query, operation name and variables",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/graphql_api.Request"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "data and errors of the query, limit and validation errors are returned in errors",
+                        "schema": {
+                            "type": "object"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            }
+        },
+        "/suggest": {
+            "get": {
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "suggest"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "prefix typed by the user, category names are matched and returned in their default language",
+                        "name": "prefix",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "suggestion count, max 20",
+                        "name": "size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model_api.SuggestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/custom_error.CustomError"
+                        }
+                    }
+                }
+            }
         }
     },
     "definitions": {
@@ -125,6 +925,21 @@ const docTemplate = `{
                 }
             }
         },
+        "graphql_api.Request": {
+            "type": "object",
+            "properties": {
+                "operationName": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
         "model_api.AdvertCategoryResponse": {
             "type": "object",
             "properties": {
@@ -136,23 +951,139 @@ const docTemplate = `{
                 }
             }
         },
+        "model_api.AdvertChangeResponse": {
+            "type": "object",
+            "properties": {
+                "advert": {
+                    "$ref": "#/definitions/model_api.AdvertResponse"
+                },
+                "deleted": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lastModifiedDate": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model_api.AdvertChangesResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_api.AdvertChangeResponse"
+                    }
+                },
+                "checkpoint": {
+                    "description": "Checkpoint is passed as checkpoint to continue the feed, it is returned even when there is no change",
+                    "type": "string"
+                },
+                "hasMore": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "model_api.AdvertCountResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "relation": {
+                    "type": "string"
+                }
+            }
+        },
         "model_api.AdvertResponse": {
             "type": "object",
             "properties": {
                 "category": {
                     "$ref": "#/definitions/model_api.AdvertCategoryResponse"
                 },
+                "city": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
+                "distance": {
+                    "description": "Distance is the distance in kilometres to the search origin",
+                    "type": "number"
+                },
+                "district": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "location": {
+                    "$ref": "#/definitions/model_api.GeoPointResponse"
+                },
                 "title": {
                     "type": "string"
                 }
             }
         },
+        "model_api.AdvertSearchResponse": {
+            "type": "object",
+            "properties": {
+                "adverts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_api.AdvertResponse"
+                    }
+                },
+                "facets": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/model_api.FacetBucketResponse"
+                        }
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model_api.CategoryAdvertsResponse": {
+            "type": "object",
+            "properties": {
+                "adverts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_api.AdvertResponse"
+                    }
+                },
+                "next": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_api.CategoryAncestorsResponse": {
+            "type": "object",
+            "properties": {
+                "ancestors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_api.CategoryResponse"
+                    }
+                }
+            }
+        },
         "model_api.CategoryResponse": {
             "type": "object",
             "properties": {
@@ -163,6 +1094,110 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "model_api.CategorySearchResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_api.CategoryResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "totalCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model_api.CategoryTreeResponse": {
+            "type": "object",
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_api.CategoryTreeResponse"
+                    }
+                },
+                "depth": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model_api.FacetBucketResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "model_api.GeoPointResponse": {
+            "type": "object",
+            "properties": {
+                "lat": {
+                    "type": "number"
+                },
+                "lon": {
+                    "type": "number"
+                }
+            }
+        },
+        "model_api.SimilarAdvertsResponse": {
+            "type": "object",
+            "properties": {
+                "adverts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_api.AdvertResponse"
+                    }
+                }
+            }
+        },
+        "model_api.SuggestResponse": {
+            "type": "object",
+            "properties": {
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model_api.SuggestionResponse"
+                    }
+                }
+            }
+        },
+        "model_api.SuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "score": {
+                    "type": "number"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
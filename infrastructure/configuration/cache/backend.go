@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"time"
+)
+
+// ErrMiss is returned by Backend.Get when the key is not cached
+var ErrMiss = errors.New("cache miss")
+
+// Backend is a cache shared by the instances of the service, values are serialized by the caller
+type Backend interface {
+	Get(ctx context.Context, key string) ([]byte, error)
+	// GetMany returns the values in the order of keys, a missing key has a nil value
+	GetMany(ctx context.Context, keys []string) ([][]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+const (
+	BackendTypeNone   = "none"
+	BackendTypeMemory = "memory"
+	BackendTypeRedis  = "redis"
+)
+
+type BackendConfig struct {
+	// Type is none, memory or redis, memory is not shared and meant for local runs
+	Type         string        `json:"type"`
+	Addresses    string        `json:"addresses"`
+	Password     string        `json:"password"`
+	Db           int           `json:"db"`
+	KeyPrefix    string        `json:"keyPrefix"`
+	DialTimeout  time.Duration `json:"dialTimeout"`
+	ReadTimeout  time.Duration `json:"readTimeout"`
+	WriteTimeout time.Duration `json:"writeTimeout"`
+}
+
+// NewBackend returns the backend of the config, nil when the shared cache is disabled
+func NewBackend(config *BackendConfig) (Backend, error) {
+	switch config.Type {
+	case "", BackendTypeNone:
+		return nil, nil
+	case BackendTypeMemory:
+		return NewMemoryBackend(), nil
+	case BackendTypeRedis:
+		return NewRedisBackend(config), nil
+	}
+	return nil, custom_error.InternalServerErrWithArgs("unknown cache backend type %s", config.Type)
+}
@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// LRU is a bounded cache whose entries expire after their ttl, an entry without a value is a cached not found
+type LRU[K comparable, V any] struct {
+	name        string
+	config      *Config
+	mutex       sync.Mutex
+	entries     map[K]*list.Element
+	recentUsage *list.List
+	now         func() time.Time
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	found     bool
+	expiresAt time.Time
+}
+
+func NewLRU[K comparable, V any](name string, config *Config) *LRU[K, V] {
+	return &LRU[K, V]{
+		name:        name,
+		config:      config,
+		entries:     make(map[K]*list.Element, config.Size),
+		recentUsage: list.New(),
+		now:         time.Now,
+	}
+}
+
+// Get returns the value of key, found is false for a cached not found and cached is false when there is no entry
+func (cache *LRU[K, V]) Get(key K) (value V, found bool, cached bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	element, exists := cache.entries[key]
+	if !exists {
+		requestCounter.WithLabelValues(cache.name, resultMiss).Inc()
+		return value, false, false
+	}
+	cacheEntry := element.Value.(*entry[K, V])
+	if !cache.now().Before(cacheEntry.expiresAt) {
+		cache.removeElement(element, EvictionReasonExpired)
+		requestCounter.WithLabelValues(cache.name, resultMiss).Inc()
+		return value, false, false
+	}
+	cache.recentUsage.MoveToFront(element)
+	if !cacheEntry.found {
+		requestCounter.WithLabelValues(cache.name, resultNegativeHit).Inc()
+		return value, false, true
+	}
+	requestCounter.WithLabelValues(cache.name, resultHit).Inc()
+	return cacheEntry.value, true, true
+}
+
+// Peek returns the value of a found entry without counting the lookup or marking the entry as used
+func (cache *LRU[K, V]) Peek(key K) (value V, found bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if element, exists := cache.entries[key]; exists {
+		cacheEntry := element.Value.(*entry[K, V])
+		if cacheEntry.found && cache.now().Before(cacheEntry.expiresAt) {
+			return cacheEntry.value, true
+		}
+	}
+	return value, false
+}
+
+// Add caches the value of key for the ttl
+func (cache *LRU[K, V]) Add(key K, value V) {
+	cache.add(&entry[K, V]{key: key, value: value, found: true, expiresAt: cache.now().Add(cache.config.Ttl)})
+}
+
+// AddNotFound caches that key does not exist for the negative ttl
+func (cache *LRU[K, V]) AddNotFound(key K) {
+	cache.add(&entry[K, V]{key: key, expiresAt: cache.now().Add(cache.config.NegativeTtl)})
+}
+
+func (cache *LRU[K, V]) Remove(key K, reason string) {
+	cache.RemoveIf(key, reason, func(V, bool) bool { return true })
+}
+
+// RemoveIf removes the entry of key when remove returns true for its value, not found entries are passed with found false
+func (cache *LRU[K, V]) RemoveIf(key K, reason string, remove func(value V, found bool) bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if element, exists := cache.entries[key]; exists {
+		cacheEntry := element.Value.(*entry[K, V])
+		if remove(cacheEntry.value, cacheEntry.found) {
+			cache.removeElement(element, reason)
+		}
+	}
+}
+
+// ReplaceIf adds the value of key unless keep returns true for the cached value
+func (cache *LRU[K, V]) ReplaceIf(key K, value V, keep func(cached V, found bool) bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if element, exists := cache.entries[key]; exists {
+		cacheEntry := element.Value.(*entry[K, V])
+		if cache.now().Before(cacheEntry.expiresAt) && keep(cacheEntry.value, cacheEntry.found) {
+			return
+		}
+	}
+	cache.addLocked(&entry[K, V]{key: key, value: value, found: true, expiresAt: cache.now().Add(cache.config.Ttl)})
+}
+
+func (cache *LRU[K, V]) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.recentUsage.Len()
+}
+
+func (cache *LRU[K, V]) add(cacheEntry *entry[K, V]) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.addLocked(cacheEntry)
+}
+
+func (cache *LRU[K, V]) addLocked(cacheEntry *entry[K, V]) {
+	if cache.config.Size <= 0 {
+		return
+	}
+	if element, exists := cache.entries[cacheEntry.key]; exists {
+		element.Value = cacheEntry
+		cache.recentUsage.MoveToFront(element)
+		return
+	}
+	cache.entries[cacheEntry.key] = cache.recentUsage.PushFront(cacheEntry)
+	for cache.recentUsage.Len() > cache.config.Size {
+		cache.removeElement(cache.recentUsage.Back(), EvictionReasonSize)
+	}
+}
+
+func (cache *LRU[K, V]) removeElement(element *list.Element, reason string) {
+	cache.recentUsage.Remove(element)
+	delete(cache.entries, element.Value.(*entry[K, V]).key)
+	evictionCounter.WithLabelValues(cache.name, reason).Inc()
+}
@@ -0,0 +1,152 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+type versionedValue struct {
+	Version int16
+}
+
+func newTestLRU[V any](config *Config) (*LRU[int64, V], *time.Time) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	lru := NewLRU[int64, V]("test", config)
+	lru.now = func() time.Time { return now }
+	return lru, &now
+}
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	lru, _ := newTestLRU[string](&Config{Size: 2, Ttl: time.Minute})
+	lru.Add(1, "1")
+	lru.Add(2, "2")
+	lru.Get(1)
+	lru.Add(3, "3")
+
+	if _, _, cached := lru.Get(2); cached {
+		t.Fatal("the least recently used key 2 was not evicted")
+	}
+	if value, found, cached := lru.Get(1); !cached || !found || value != "1" {
+		t.Fatalf("Get(1) returned %q, %v, %v", value, found, cached)
+	}
+	if value, found, cached := lru.Get(3); !cached || !found || value != "3" {
+		t.Fatalf("Get(3) returned %q, %v, %v", value, found, cached)
+	}
+	if lru.Len() != 2 {
+		t.Fatalf("Len is %d, want 2", lru.Len())
+	}
+}
+
+func TestLRU_ZeroSizeCachesNothing(t *testing.T) {
+	lru, _ := newTestLRU[string](&Config{Size: 0, Ttl: time.Minute})
+	lru.Add(1, "1")
+	lru.AddNotFound(2)
+
+	if lru.Len() != 0 {
+		t.Fatalf("Len is %d, want 0", lru.Len())
+	}
+}
+
+func TestLRU_Ttl(t *testing.T) {
+	lru, now := newTestLRU[string](&Config{Size: 10, Ttl: time.Minute})
+	lru.Add(1, "1")
+
+	*now = now.Add(time.Minute - time.Nanosecond)
+	if _, found, cached := lru.Get(1); !cached || !found {
+		t.Fatal("the entry expired before its ttl")
+	}
+	if _, found := lru.Peek(1); !found {
+		t.Fatal("Peek did not return the entry before its ttl")
+	}
+	*now = now.Add(time.Nanosecond)
+	if _, found := lru.Peek(1); found {
+		t.Fatal("Peek returned an expired entry")
+	}
+	if _, _, cached := lru.Get(1); cached {
+		t.Fatal("Get returned an expired entry")
+	}
+	if lru.Len() != 0 {
+		t.Fatalf("the expired entry was not removed, Len is %d", lru.Len())
+	}
+}
+
+func TestLRU_NotFound(t *testing.T) {
+	lru, now := newTestLRU[string](&Config{Size: 10, Ttl: time.Hour, NegativeTtl: time.Minute})
+	lru.AddNotFound(1)
+
+	if _, found, cached := lru.Get(1); !cached || found {
+		t.Fatalf("Get of a not found returned found %v, cached %v", found, cached)
+	}
+	if _, found := lru.Peek(1); found {
+		t.Fatal("Peek returned a not found as found")
+	}
+	*now = now.Add(time.Minute)
+	if _, _, cached := lru.Get(1); cached {
+		t.Fatal("the not found was kept beyond the negative ttl")
+	}
+
+	lru.AddNotFound(2)
+	lru.Add(2, "2")
+	if value, found, cached := lru.Get(2); !cached || !found || value != "2" {
+		t.Fatalf("Add did not replace the not found, Get returned %q, %v, %v", value, found, cached)
+	}
+}
+
+func TestLRU_ReplaceIfNewerVersion(t *testing.T) {
+	lru, now := newTestLRU[*versionedValue](&Config{Size: 10, Ttl: time.Minute})
+	keepNewer := func(value *versionedValue) func(*versionedValue, bool) bool {
+		return func(cached *versionedValue, found bool) bool {
+			return found && cached.Version > value.Version
+		}
+	}
+	replace := func(version int16) {
+		value := &versionedValue{Version: version}
+		lru.ReplaceIf(1, value, keepNewer(value))
+	}
+
+	replace(2)
+	replace(1)
+	if value, _ := lru.Peek(1); value.Version != 2 {
+		t.Fatalf("an older version replaced version 2, cached version is %d", value.Version)
+	}
+	replace(3)
+	if value, _ := lru.Peek(1); value.Version != 3 {
+		t.Fatalf("the newer version 3 did not replace version 2, cached version is %d", value.Version)
+	}
+
+	lru.AddNotFound(1)
+	replace(1)
+	if value, found := lru.Peek(1); !found || value.Version != 1 {
+		t.Fatal("ReplaceIf did not replace a not found")
+	}
+
+	*now = now.Add(time.Minute)
+	replace(0)
+	if value, found := lru.Peek(1); !found || value.Version != 0 {
+		t.Fatal("ReplaceIf kept an expired newer version")
+	}
+}
+
+func TestLRU_RemoveIf(t *testing.T) {
+	lru, _ := newTestLRU[*versionedValue](&Config{Size: 10, Ttl: time.Minute, NegativeTtl: time.Minute})
+	lru.Add(1, &versionedValue{Version: 2})
+	lru.AddNotFound(2)
+	olderThan := func(version int16) func(*versionedValue, bool) bool {
+		return func(cached *versionedValue, found bool) bool {
+			return !found || cached.Version < version
+		}
+	}
+
+	lru.RemoveIf(1, EvictionReasonVersion, olderThan(2))
+	if _, found := lru.Peek(1); !found {
+		t.Fatal("RemoveIf removed an entry that is not older")
+	}
+	lru.RemoveIf(1, EvictionReasonVersion, olderThan(3))
+	if _, _, cached := lru.Get(1); cached {
+		t.Fatal("RemoveIf kept an older entry")
+	}
+	lru.RemoveIf(2, EvictionReasonVersion, olderThan(1))
+	if _, _, cached := lru.Get(2); cached {
+		t.Fatal("RemoveIf kept a not found")
+	}
+}
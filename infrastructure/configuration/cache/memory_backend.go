@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryBackend keeps the values in process, it stands in for a shared backend in local runs
+type MemoryBackend struct {
+	mutex     sync.RWMutex
+	values    map[string]memoryValue
+	sweepSize int
+	now       func() time.Time
+}
+
+const minSweepSize = 1024
+
+type memoryValue struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{
+		values:    make(map[string]memoryValue),
+		sweepSize: minSweepSize,
+		now:       time.Now,
+	}
+}
+
+func (backend *MemoryBackend) Get(_ context.Context, key string) ([]byte, error) {
+	backend.mutex.RLock()
+	defer backend.mutex.RUnlock()
+	if value, exists := backend.values[key]; exists && backend.now().Before(value.expiresAt) {
+		return value.value, nil
+	}
+	return nil, ErrMiss
+}
+
+func (backend *MemoryBackend) GetMany(ctx context.Context, keys []string) ([][]byte, error) {
+	values := make([][]byte, len(keys))
+	for i, key := range keys {
+		if value, err := backend.Get(ctx, key); err == nil {
+			values[i] = value
+		}
+	}
+	return values, nil
+}
+
+// Set drops the expired values whenever the value count doubles, the backend is not bounded otherwise
+func (backend *MemoryBackend) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	now := backend.now()
+	if len(backend.values) >= backend.sweepSize {
+		for existingKey, existingValue := range backend.values {
+			if !now.Before(existingValue.expiresAt) {
+				delete(backend.values, existingKey)
+			}
+		}
+		backend.sweepSize = max(2*len(backend.values), minSweepSize)
+	}
+	backend.values[key] = memoryValue{value: value, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+func (backend *MemoryBackend) Delete(_ context.Context, keys ...string) error {
+	backend.mutex.Lock()
+	defer backend.mutex.Unlock()
+	for _, key := range keys {
+		delete(backend.values, key)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newTestMemoryBackend() (*MemoryBackend, *time.Time) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	backend := NewMemoryBackend()
+	backend.now = func() time.Time { return now }
+	return backend, &now
+}
+
+func TestMemoryBackend_GetAndTtl(t *testing.T) {
+	backend, now := newTestMemoryBackend()
+	ctx := context.Background()
+
+	if _, err := backend.Get(ctx, "adverts:1"); !errors.Is(err, ErrMiss) {
+		t.Fatalf("Get of a missing key returned %v, want ErrMiss", err)
+	}
+	if err := backend.Set(ctx, "adverts:1", []byte("1"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := backend.Get(ctx, "adverts:1"); err != nil || string(value) != "1" {
+		t.Fatalf("Get returned %q, %v", value, err)
+	}
+	*now = now.Add(time.Minute)
+	if _, err := backend.Get(ctx, "adverts:1"); !errors.Is(err, ErrMiss) {
+		t.Fatalf("Get of an expired key returned %v, want ErrMiss", err)
+	}
+}
+
+func TestMemoryBackend_GetMany(t *testing.T) {
+	backend, _ := newTestMemoryBackend()
+	ctx := context.Background()
+	_ = backend.Set(ctx, "adverts:1", []byte("1"), time.Minute)
+	_ = backend.Set(ctx, "adverts:3", []byte("3"), time.Minute)
+
+	values, err := backend.GetMany(ctx, []string{"adverts:1", "adverts:2", "adverts:3"})
+	if err != nil || len(values) != 3 || string(values[0]) != "1" || values[1] != nil || string(values[2]) != "3" {
+		t.Fatalf("GetMany returned %q, %v", values, err)
+	}
+}
+
+func TestMemoryBackend_Delete(t *testing.T) {
+	backend, _ := newTestMemoryBackend()
+	ctx := context.Background()
+	_ = backend.Set(ctx, "adverts:1", []byte("1"), time.Minute)
+	_ = backend.Set(ctx, "adverts:2", []byte("2"), time.Minute)
+
+	if err := backend.Delete(ctx, "adverts:1", "adverts:3"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := backend.Get(ctx, "adverts:1"); !errors.Is(err, ErrMiss) {
+		t.Fatalf("Get of a deleted key returned %v, want ErrMiss", err)
+	}
+	if _, err := backend.Get(ctx, "adverts:2"); err != nil {
+		t.Fatalf("Delete removed another key, %v", err)
+	}
+}
+
+func TestMemoryBackend_SetSweepsExpiredValues(t *testing.T) {
+	backend, now := newTestMemoryBackend()
+	ctx := context.Background()
+	for i := 1; i < minSweepSize; i++ {
+		_ = backend.Set(ctx, "adverts:"+strconv.Itoa(i), nil, time.Minute)
+	}
+	_ = backend.Set(ctx, "live", nil, time.Hour)
+	*now = now.Add(time.Minute)
+
+	_ = backend.Set(ctx, "new", nil, time.Minute)
+	if len(backend.values) != 2 {
+		t.Fatalf("%d values after the sweep, want 2", len(backend.values))
+	}
+	if backend.sweepSize != minSweepSize {
+		t.Fatalf("sweep size is %d, want %d", backend.sweepSize, minSweepSize)
+	}
+}
@@ -0,0 +1,28 @@
+package cache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	resultHit         = "hit"
+	resultNegativeHit = "negative_hit"
+	resultMiss        = "miss"
+	resultError       = "error"
+
+	EvictionReasonSize    = "size"
+	EvictionReasonExpired = "expired"
+	EvictionReasonVersion = "version"
+)
+
+var (
+	requestCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "read_cache_requests_total",
+		Help: "Read cache lookups by result, negative_hit is a cached not found and error is a failed shared cache lookup",
+	}, []string{"cache", "result"})
+	evictionCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "read_cache_evictions_total",
+		Help: "Read cache entries removed before they were replaced, by reason",
+	}, []string{"cache", "reason"})
+)
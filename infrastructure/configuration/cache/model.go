@@ -0,0 +1,25 @@
+package cache
+
+import (
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"strings"
+	"time"
+)
+
+type ConfigMap map[string]*Config
+
+func (c ConfigMap) GetConfig(name string) (*Config, error) {
+	if config, exists := c[strings.ToLower(name)]; exists {
+		return config, nil
+	}
+	return nil, custom_error.NewConfigNotFoundErr(name)
+}
+
+type Config struct {
+	// Size is the max entry count, the least recently used entry is evicted when it is exceeded
+	Size        int           `json:"size"`
+	Ttl         time.Duration `json:"ttl"`
+	NegativeTtl time.Duration `json:"negativeTtl"`
+	// SharedTtl is the ttl in the shared backend, zero keeps the entries out of it
+	SharedTtl time.Duration `json:"sharedTtl"`
+}
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"strings"
+	"time"
+)
+
+type RedisBackend struct {
+	client    redis.UniversalClient
+	keyPrefix string
+}
+
+// NewRedisBackend connects to a single node, a sentinel or a cluster depending on the comma separated addresses
+func NewRedisBackend(config *BackendConfig) *RedisBackend {
+	client := redis.NewUniversalClient(&redis.UniversalOptions{
+		Addrs:        strings.Split(config.Addresses, ","),
+		Password:     config.Password,
+		DB:           config.Db,
+		DialTimeout:  config.DialTimeout,
+		ReadTimeout:  config.ReadTimeout,
+		WriteTimeout: config.WriteTimeout,
+	})
+	return NewRedisBackendWithClient(client, config.KeyPrefix)
+}
+
+func NewRedisBackendWithClient(client redis.UniversalClient, keyPrefix string) *RedisBackend {
+	return &RedisBackend{client: client, keyPrefix: keyPrefix}
+}
+
+func (backend *RedisBackend) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := backend.client.Get(ctx, backend.keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrMiss
+	}
+	return value, err
+}
+
+// GetMany pipelines a GET per key, unlike MGET it works when the keys are on different cluster slots
+func (backend *RedisBackend) GetMany(ctx context.Context, keys []string) ([][]byte, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	commands := make([]*redis.StringCmd, 0, len(keys))
+	_, err := backend.client.Pipelined(ctx, func(pipeliner redis.Pipeliner) error {
+		for _, key := range keys {
+			commands = append(commands, pipeliner.Get(ctx, backend.keyPrefix+key))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	values := make([][]byte, len(keys))
+	for i, command := range commands {
+		if value, err := command.Bytes(); err == nil {
+			values[i] = value
+		}
+	}
+	return values, nil
+}
+
+func (backend *RedisBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return backend.client.Set(ctx, backend.keyPrefix+key, value, ttl).Err()
+}
+
+func (backend *RedisBackend) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	_, err := backend.client.Pipelined(ctx, func(pipeliner redis.Pipeliner) error {
+		for _, key := range keys {
+			pipeliner.Del(ctx, backend.keyPrefix+key)
+		}
+		return nil
+	})
+	return err
+}
+
+func (backend *RedisBackend) Close() error {
+	return backend.client.Close()
+}
@@ -0,0 +1,129 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"testing"
+	"time"
+)
+
+func newTestRedisBackend(t *testing.T, keyPrefix string) (*RedisBackend, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+	backend := NewRedisBackendWithClient(redis.NewClient(&redis.Options{Addr: server.Addr()}), keyPrefix)
+	t.Cleanup(func() { _ = backend.Close() })
+	return backend, server
+}
+
+func TestRedisBackend_Get(t *testing.T) {
+	backend, _ := newTestRedisBackend(t, "")
+	ctx := context.Background()
+
+	if _, err := backend.Get(ctx, "adverts:1"); !errors.Is(err, ErrMiss) {
+		t.Fatalf("Get of a missing key returned %v, want ErrMiss", err)
+	}
+	if err := backend.Set(ctx, "adverts:1", []byte(`{"id":1}`), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	value, err := backend.Get(ctx, "adverts:1")
+	if err != nil || string(value) != `{"id":1}` {
+		t.Fatalf("Get returned %q, %v", value, err)
+	}
+}
+
+func TestRedisBackend_GetMany(t *testing.T) {
+	backend, _ := newTestRedisBackend(t, "")
+	ctx := context.Background()
+	for _, key := range []string{"adverts:1", "adverts:3"} {
+		if err := backend.Set(ctx, key, []byte(key), time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	values, err := backend.GetMany(ctx, []string{"adverts:1", "adverts:2", "adverts:3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 3 || string(values[0]) != "adverts:1" || values[1] != nil || string(values[2]) != "adverts:3" {
+		t.Fatalf("GetMany returned %q", values)
+	}
+
+	values, err = backend.GetMany(ctx, []string{"adverts:4", "adverts:5"})
+	if err != nil || len(values) != 2 || values[0] != nil || values[1] != nil {
+		t.Fatalf("GetMany of missing keys returned %q, %v", values, err)
+	}
+}
+
+func TestRedisBackend_SetTtl(t *testing.T) {
+	backend, server := newTestRedisBackend(t, "")
+	ctx := context.Background()
+	if err := backend.Set(ctx, "adverts:1", []byte("1"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	if ttl := server.TTL("adverts:1"); ttl != time.Minute {
+		t.Fatalf("ttl is %v, want %v", ttl, time.Minute)
+	}
+	server.FastForward(time.Minute)
+	if _, err := backend.Get(ctx, "adverts:1"); !errors.Is(err, ErrMiss) {
+		t.Fatalf("Get of an expired key returned %v, want ErrMiss", err)
+	}
+}
+
+func TestRedisBackend_Delete(t *testing.T) {
+	backend, server := newTestRedisBackend(t, "")
+	ctx := context.Background()
+	for _, key := range []string{"adverts:1", "adverts:2", "adverts:3"} {
+		if err := backend.Set(ctx, key, []byte(key), time.Minute); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := backend.Delete(ctx, "adverts:1", "adverts:2", "adverts:4"); err != nil {
+		t.Fatal(err)
+	}
+	if keys := server.Keys(); len(keys) != 1 || keys[0] != "adverts:3" {
+		t.Fatalf("keys after Delete are %v", keys)
+	}
+	if err := backend.Delete(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRedisBackend_KeyPrefix(t *testing.T) {
+	backend, server := newTestRedisBackend(t, "read-api:")
+	ctx := context.Background()
+	if err := backend.Set(ctx, "adverts:1", []byte("1"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	if keys := server.Keys(); len(keys) != 1 || keys[0] != "read-api:adverts:1" {
+		t.Fatalf("keys are %v, want the prefixed key", keys)
+	}
+	if value, err := backend.Get(ctx, "adverts:1"); err != nil || string(value) != "1" {
+		t.Fatalf("Get returned %q, %v", value, err)
+	}
+	if values, err := backend.GetMany(ctx, []string{"adverts:1"}); err != nil || string(values[0]) != "1" {
+		t.Fatalf("GetMany returned %q, %v", values, err)
+	}
+	if err := backend.Delete(ctx, "adverts:1"); err != nil {
+		t.Fatal(err)
+	}
+	if server.Exists("read-api:adverts:1") {
+		t.Fatal("Delete kept the prefixed key")
+	}
+}
+
+func TestRedisBackend_Unavailable(t *testing.T) {
+	backend, server := newTestRedisBackend(t, "")
+	server.Close()
+	ctx := context.Background()
+
+	if _, err := backend.Get(ctx, "adverts:1"); err == nil || errors.Is(err, ErrMiss) {
+		t.Fatalf("Get returned %v, want a connection error", err)
+	}
+	if _, err := backend.GetMany(ctx, []string{"adverts:1"}); err == nil {
+		t.Fatal("GetMany returned no error")
+	}
+}
@@ -0,0 +1,154 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"presentation-advert-read-api/infrastructure/configuration/custom_json"
+	"presentation-advert-read-api/infrastructure/configuration/log"
+	"sync/atomic"
+	"time"
+)
+
+// notFoundValue is stored for a cached not found
+var notFoundValue = []byte("null")
+
+// backendRetryInterval is how long the backend is skipped after it failed, so an unavailable backend
+// does not add its timeout to every read
+const backendRetryInterval = 5 * time.Second
+
+// Shared caches values as json in a Backend under name:key. A failing backend is logged and treated as a miss,
+// so reads go on to the source. The methods of a nil Shared do nothing and always miss.
+type Shared[K comparable, V any] struct {
+	name    string
+	backend Backend
+	config  *Config
+	// retryAt is the unix nano time the backend is used again after a failure
+	retryAt atomic.Int64
+}
+
+// NewShared returns nil when there is no backend or the config has no shared ttl
+func NewShared[K comparable, V any](name string, backend Backend, config *Config) *Shared[K, V] {
+	if backend == nil || config.SharedTtl <= 0 {
+		return nil
+	}
+	return &Shared[K, V]{name: name, backend: backend, config: config}
+}
+
+// Get returns the value of key, found is false for a cached not found and cached is false when there is no entry
+func (shared *Shared[K, V]) Get(ctx context.Context, key K) (value *V, found bool, cached bool) {
+	if !shared.available() {
+		return nil, false, false
+	}
+	valueBytes, err := shared.backend.Get(ctx, shared.key(key))
+	if err != nil {
+		if !errors.Is(err, ErrMiss) {
+			shared.failed("Get", err)
+			return nil, false, false
+		}
+		requestCounter.WithLabelValues(shared.metricName(), resultMiss).Inc()
+		return nil, false, false
+	}
+	return shared.decode(valueBytes)
+}
+
+// GetMany returns the cached values and not founds of keys, the other keys are returned as uncached
+func (shared *Shared[K, V]) GetMany(ctx context.Context, keys []K) (values map[K]*V, notFoundKeys []K, uncachedKeys []K) {
+	if !shared.available() || len(keys) == 0 {
+		return nil, nil, keys
+	}
+	backendKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		backendKeys = append(backendKeys, shared.key(key))
+	}
+	valueBytes, err := shared.backend.GetMany(ctx, backendKeys)
+	if err != nil {
+		shared.failed("GetMany", err)
+		return nil, nil, keys
+	}
+	values = make(map[K]*V, len(keys))
+	for i, key := range keys {
+		if valueBytes[i] == nil {
+			requestCounter.WithLabelValues(shared.metricName(), resultMiss).Inc()
+			uncachedKeys = append(uncachedKeys, key)
+			continue
+		}
+		value, found, cached := shared.decode(valueBytes[i])
+		switch {
+		case !cached:
+			uncachedKeys = append(uncachedKeys, key)
+		case found:
+			values[key] = value
+		default:
+			notFoundKeys = append(notFoundKeys, key)
+		}
+	}
+	return values, notFoundKeys, uncachedKeys
+}
+
+func (shared *Shared[K, V]) Add(ctx context.Context, key K, value *V) {
+	if !shared.available() {
+		return
+	}
+	valueBytes, err := custom_json.Marshal(value)
+	if err != nil {
+		shared.failed("Add", err)
+		return
+	}
+	if err := shared.backend.Set(ctx, shared.key(key), valueBytes, shared.config.SharedTtl); err != nil {
+		shared.failed("Add", err)
+	}
+}
+
+func (shared *Shared[K, V]) AddNotFound(ctx context.Context, key K) {
+	if !shared.available() {
+		return
+	}
+	if err := shared.backend.Set(ctx, shared.key(key), notFoundValue, shared.config.NegativeTtl); err != nil {
+		shared.failed("AddNotFound", err)
+	}
+}
+
+func (shared *Shared[K, V]) Remove(ctx context.Context, key K) {
+	if !shared.available() {
+		return
+	}
+	if err := shared.backend.Delete(ctx, shared.key(key)); err != nil {
+		shared.failed("Remove", err)
+	}
+}
+
+func (shared *Shared[K, V]) decode(valueBytes []byte) (value *V, found bool, cached bool) {
+	if err := custom_json.Unmarshal(valueBytes, &value); err != nil {
+		requestCounter.WithLabelValues(shared.metricName(), resultError).Inc()
+		log.Warnf("Shared cache %s, decode error, %v", shared.name, err)
+		return nil, false, false
+	}
+	if value == nil {
+		requestCounter.WithLabelValues(shared.metricName(), resultNegativeHit).Inc()
+		return nil, false, true
+	}
+	requestCounter.WithLabelValues(shared.metricName(), resultHit).Inc()
+	return value, true, true
+}
+
+func (shared *Shared[K, V]) available() bool {
+	return shared != nil && time.Now().UnixNano() >= shared.retryAt.Load()
+}
+
+// failed skips the backend for the retry interval unless the caller has gone
+func (shared *Shared[K, V]) failed(operation string, err error) {
+	if !errors.Is(err, context.Canceled) {
+		shared.retryAt.Store(time.Now().Add(backendRetryInterval).UnixNano())
+	}
+	requestCounter.WithLabelValues(shared.metricName(), resultError).Inc()
+	log.Warnf("Shared cache %s, %s error, %v", shared.name, operation, err)
+}
+
+func (shared *Shared[K, V]) key(key K) string {
+	return fmt.Sprintf("%s:%v", shared.name, key)
+}
+
+func (shared *Shared[K, V]) metricName() string {
+	return shared.name + "_shared"
+}
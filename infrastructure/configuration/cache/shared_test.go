@@ -0,0 +1,184 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type testValue struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// failingBackend fails every call with err and counts the calls
+type failingBackend struct {
+	err   error
+	calls int
+}
+
+func (backend *failingBackend) Get(context.Context, string) ([]byte, error) {
+	backend.calls++
+	return nil, backend.err
+}
+
+func (backend *failingBackend) GetMany(context.Context, []string) ([][]byte, error) {
+	backend.calls++
+	return nil, backend.err
+}
+
+func (backend *failingBackend) Set(context.Context, string, []byte, time.Duration) error {
+	backend.calls++
+	return backend.err
+}
+
+func (backend *failingBackend) Delete(context.Context, ...string) error {
+	backend.calls++
+	return backend.err
+}
+
+var testSharedConfig = &Config{Size: 10, Ttl: time.Minute, NegativeTtl: 10 * time.Second, SharedTtl: 5 * time.Minute}
+
+func TestNewShared_Disabled(t *testing.T) {
+	if NewShared[int64, testValue]("adverts", nil, testSharedConfig) != nil {
+		t.Fatal("NewShared without a backend is not nil")
+	}
+	if NewShared[int64, testValue]("adverts", NewMemoryBackend(), &Config{Size: 10, Ttl: time.Minute}) != nil {
+		t.Fatal("NewShared without a shared ttl is not nil")
+	}
+
+	var shared *Shared[int64, testValue]
+	ctx := context.Background()
+	shared.Add(ctx, 1, &testValue{Id: 1})
+	shared.AddNotFound(ctx, 2)
+	shared.Remove(ctx, 1)
+	if _, _, cached := shared.Get(ctx, 1); cached {
+		t.Fatal("Get of a nil Shared is cached")
+	}
+	if _, _, uncachedKeys := shared.GetMany(ctx, []int64{1, 2}); len(uncachedKeys) != 2 {
+		t.Fatalf("GetMany of a nil Shared returned uncached keys %v", uncachedKeys)
+	}
+}
+
+func TestShared_GetAndRemove(t *testing.T) {
+	backend, _ := newTestMemoryBackend()
+	shared := NewShared[int64, testValue]("adverts", backend, testSharedConfig)
+	ctx := context.Background()
+
+	if _, _, cached := shared.Get(ctx, 1); cached {
+		t.Fatal("Get of a missing key is cached")
+	}
+	shared.Add(ctx, 1, &testValue{Id: 1, Name: "advert"})
+	if _, err := backend.Get(ctx, "adverts:1"); err != nil {
+		t.Fatalf("value is not stored under name:key, %v", err)
+	}
+	value, found, cached := shared.Get(ctx, 1)
+	if !cached || !found || *value != (testValue{Id: 1, Name: "advert"}) {
+		t.Fatalf("Get returned %v, %v, %v", value, found, cached)
+	}
+	shared.Remove(ctx, 1)
+	if _, _, cached := shared.Get(ctx, 1); cached {
+		t.Fatal("Get of a removed key is cached")
+	}
+}
+
+func TestShared_NotFound(t *testing.T) {
+	backend, now := newTestMemoryBackend()
+	shared := NewShared[int64, testValue]("adverts", backend, testSharedConfig)
+	ctx := context.Background()
+
+	shared.AddNotFound(ctx, 1)
+	value, found, cached := shared.Get(ctx, 1)
+	if !cached || found || value != nil {
+		t.Fatalf("Get of a not found returned %v, %v, %v", value, found, cached)
+	}
+	*now = now.Add(testSharedConfig.NegativeTtl)
+	if _, _, cached := shared.Get(ctx, 1); cached {
+		t.Fatal("not found outlived the negative ttl")
+	}
+}
+
+func TestShared_Ttl(t *testing.T) {
+	backend, now := newTestMemoryBackend()
+	shared := NewShared[int64, testValue]("adverts", backend, testSharedConfig)
+	ctx := context.Background()
+
+	shared.Add(ctx, 1, &testValue{Id: 1})
+	*now = now.Add(testSharedConfig.SharedTtl - time.Second)
+	if _, _, cached := shared.Get(ctx, 1); !cached {
+		t.Fatal("value expired before the shared ttl")
+	}
+	*now = now.Add(time.Second)
+	if _, _, cached := shared.Get(ctx, 1); cached {
+		t.Fatal("value outlived the shared ttl")
+	}
+}
+
+func TestShared_GetMany(t *testing.T) {
+	backend, _ := newTestMemoryBackend()
+	shared := NewShared[int64, testValue]("adverts", backend, testSharedConfig)
+	ctx := context.Background()
+	shared.Add(ctx, 1, &testValue{Id: 1})
+	shared.AddNotFound(ctx, 2)
+	_ = backend.Set(ctx, "adverts:4", []byte("{"), time.Minute)
+
+	values, notFoundKeys, uncachedKeys := shared.GetMany(ctx, []int64{1, 2, 3, 4})
+	if len(values) != 1 || values[1] == nil || values[1].Id != 1 {
+		t.Fatalf("values are %v", values)
+	}
+	if len(notFoundKeys) != 1 || notFoundKeys[0] != 2 {
+		t.Fatalf("not found keys are %v", notFoundKeys)
+	}
+	if len(uncachedKeys) != 2 || uncachedKeys[0] != 3 || uncachedKeys[1] != 4 {
+		t.Fatalf("uncached keys are %v, want the missing and the undecodable key", uncachedKeys)
+	}
+}
+
+func TestShared_BackendError(t *testing.T) {
+	backend := &failingBackend{err: errors.New("connection refused")}
+	shared := NewShared[int64, testValue]("adverts", backend, testSharedConfig)
+	ctx := context.Background()
+
+	if _, _, cached := shared.Get(ctx, 1); cached {
+		t.Fatal("Get of a failing backend is cached")
+	}
+	if backend.calls != 1 {
+		t.Fatalf("backend was called %d times, want 1", backend.calls)
+	}
+
+	// the backend is skipped until the retry window ends
+	shared.Add(ctx, 1, &testValue{Id: 1})
+	shared.AddNotFound(ctx, 2)
+	shared.Remove(ctx, 1)
+	_, _, uncachedKeys := shared.GetMany(ctx, []int64{1, 2})
+	if len(uncachedKeys) != 2 {
+		t.Fatalf("GetMany of a failing backend returned uncached keys %v", uncachedKeys)
+	}
+	if backend.calls != 1 {
+		t.Fatalf("backend was called %d times within the retry window, want 1", backend.calls)
+	}
+	if retryIn := time.Until(time.Unix(0, shared.retryAt.Load())); retryIn <= 0 || retryIn > backendRetryInterval {
+		t.Fatalf("backend is retried in %v, want at most %v", retryIn, backendRetryInterval)
+	}
+
+	shared.retryAt.Store(time.Now().UnixNano())
+	if _, _, uncachedKeys := shared.GetMany(ctx, []int64{1, 2}); len(uncachedKeys) != 2 {
+		t.Fatalf("GetMany of a failing backend returned uncached keys %v", uncachedKeys)
+	}
+	if backend.calls != 2 {
+		t.Fatalf("backend was called %d times after the retry window, want 2", backend.calls)
+	}
+}
+
+func TestShared_CanceledContext(t *testing.T) {
+	backend := &failingBackend{err: context.Canceled}
+	shared := NewShared[int64, testValue]("adverts", backend, testSharedConfig)
+	ctx := context.Background()
+
+	shared.Get(ctx, 1)
+	shared.Get(ctx, 1)
+	if backend.calls != 2 {
+		t.Fatalf("backend was called %d times, a canceled caller must not start the retry window", backend.calls)
+	}
+}
@@ -3,7 +3,9 @@ package configreader
 import (
 	"github.com/spf13/viper"
 	"os"
+	"presentation-advert-read-api/infrastructure/configuration/cache"
 	"presentation-advert-read-api/infrastructure/configuration/elastic"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
 	"presentation-advert-read-api/infrastructure/configuration/log"
 	"presentation-advert-read-api/infrastructure/configuration/server"
 )
@@ -31,6 +33,15 @@ func ReadLogConfig(logConfigPath string) *log.Config {
 	return &conf
 }
 
+func ReadLocaleConfig(localeConfigPath string) *locale.Config {
+	var conf locale.Config
+	err := readFile(&conf, localeConfigPath)
+	if err != nil {
+		log.Panic("Locale Config file couldn't read")
+	}
+	return &conf
+}
+
 func ReadElasticConfig(elasticConfigPath string) elastic.ConfigMap {
 	var conf map[string]*elastic.Config
 	err := readFile(&conf, elasticConfigPath)
@@ -40,6 +51,24 @@ func ReadElasticConfig(elasticConfigPath string) elastic.ConfigMap {
 	return conf
 }
 
+func ReadCacheConfig(cacheConfigPath string) cache.ConfigMap {
+	var conf map[string]*cache.Config
+	err := readFile(&conf, cacheConfigPath)
+	if err != nil {
+		log.Panic("Cache Config file couldn't read")
+	}
+	return conf
+}
+
+func ReadCacheBackendConfig(cacheBackendConfigPath string) *cache.BackendConfig {
+	var conf cache.BackendConfig
+	err := readFile(&conf, cacheBackendConfigPath)
+	if err != nil {
+		log.Panic("Cache Backend Config file couldn't read")
+	}
+	return &conf
+}
+
 func GetProfile(envName string, defaultValue string) string {
 	profile := os.Getenv(envName)
 	if profile == "" {
@@ -0,0 +1,34 @@
+package custom_json
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"net/http"
+)
+
+// EchoSerializer makes echo encode responses and bind request bodies with JsonIter instead of encoding/json
+type EchoSerializer struct{}
+
+// Serialize writes through a pooled stream, its buffer keeps the capacity of earlier responses
+func (serializer EchoSerializer) Serialize(c echo.Context, i interface{}, indent string) error {
+	if indent != "" {
+		encoder := JsonIter.NewEncoder(c.Response())
+		encoder.SetIndent("", indent)
+		return encoder.Encode(i)
+	}
+	stream := JsonIter.BorrowStream(c.Response())
+	defer JsonIter.ReturnStream(stream)
+	stream.WriteVal(i)
+	stream.WriteRaw("\n")
+	if stream.Error != nil {
+		return stream.Error
+	}
+	return stream.Flush()
+}
+
+func (serializer EchoSerializer) Deserialize(c echo.Context, i interface{}) error {
+	if err := JsonIter.NewDecoder(c.Request().Body).Decode(i); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Json decode error: %v", err)).SetInternal(err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package custom_json_test
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"net/http/httptest"
+	"presentation-advert-read-api/infrastructure/configuration/custom_json"
+	"presentation-advert-read-api/model/model_api"
+	"strings"
+	"testing"
+)
+
+// BenchmarkSerialize encodes a search page of the max size with each serializer into a writer that discards it,
+// so only the serializer is measured
+func BenchmarkSerialize(b *testing.B) {
+	response := searchResponse(100)
+	serializers := []struct {
+		name       string
+		serializer echo.JSONSerializer
+	}{
+		{name: "encoding_json", serializer: echo.DefaultJSONSerializer{}},
+		{name: "custom_json", serializer: custom_json.EchoSerializer{}},
+	}
+	for _, serializer := range serializers {
+		b.Run(serializer.name, func(b *testing.B) {
+			writer := &discardResponseWriter{header: http.Header{}}
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/adverts", nil), writer)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := serializer.serializer.Serialize(c, response, ""); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.SetBytes(int64(writer.written) / int64(b.N))
+		})
+	}
+}
+
+type discardResponseWriter struct {
+	header  http.Header
+	written int
+}
+
+func (writer *discardResponseWriter) Header() http.Header {
+	return writer.header
+}
+
+func (writer *discardResponseWriter) Write(data []byte) (int, error) {
+	writer.written += len(data)
+	return len(data), nil
+}
+
+func (writer *discardResponseWriter) WriteHeader(int) {}
+
+func searchResponse(size int) *model_api.AdvertSearchResponse {
+	adverts := make([]model_api.AdvertResponse, 0, size)
+	for i := 0; i < size; i++ {
+		adverts = append(adverts, model_api.AdvertResponse{
+			Id:          int64(i),
+			Title:       fmt.Sprintf("Sahibinden satılık 3+1 daire %d", i),
+			Description: strings.Repeat("Metroya yürüme mesafesinde, güney cepheli, otoparklı. ", 20),
+			Category:    model_api.AdvertCategoryResponse{Id: int64(i % 10), Name: "Satılık Daire"},
+			Location:    &model_api.GeoPointResponse{Lat: 41.0082, Lon: 28.9784},
+			City:        "İstanbul",
+			District:    "Kadıköy",
+		})
+	}
+	return &model_api.AdvertSearchResponse{Adverts: adverts, Size: size, TotalCount: 10000}
+}
@@ -0,0 +1,33 @@
+package elastic
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"presentation-advert-read-api/infrastructure/configuration/custom_json"
+)
+
+// EncodeSearchAfter turns the sort values of the last hit into an opaque cursor
+func EncodeSearchAfter(sortValues []json.RawMessage) (string, error) {
+	if len(sortValues) == 0 {
+		return "", nil
+	}
+	bytes, err := custom_json.Marshal(sortValues)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// DecodeSearchAfter turns a cursor created by EncodeSearchAfter back into search_after values
+func DecodeSearchAfter(cursor string) ([]json.RawMessage, error) {
+	bytes, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, custom_error.BadRequestErr("cursor is not valid")
+	}
+	var sortValues []json.RawMessage
+	if err := custom_json.Unmarshal(bytes, &sortValues); err != nil || len(sortValues) == 0 {
+		return nil, custom_error.BadRequestErr("cursor is not valid")
+	}
+	return sortValues, nil
+}
@@ -15,6 +15,8 @@ import (
 	"time"
 )
 
+const suggestionName = "suggestion"
+
 type baseRepository struct {
 	Client      *elasticsearch.Client
 	IndexName   string
@@ -224,16 +226,21 @@ func (repository *baseRepository) DeleteById(ctx context.Context, document *elas
 }
 
 func (repository *baseRepository) Search(ctx context.Context, query map[string]interface{}) (*elastic.SearchResponse, error) {
+	// total hits are disabled unless the query asks for them explicitly
+	_, trackTotalHits := query["track_total_hits"]
 	var response *esapi.Response
 	err := retry.Do(
 		func() error {
-			var err error
-			response, err = repository.Client.Search(
+			options := []func(*esapi.SearchRequest){
 				repository.Client.Search.WithContext(ctx),
 				repository.Client.Search.WithIndex(repository.IndexName),
 				repository.Client.Search.WithBody(esutil.NewJSONReader(&query)),
-				repository.Client.Search.WithTrackTotalHits(false),
-			)
+			}
+			if !trackTotalHits {
+				options = append(options, repository.Client.Search.WithTrackTotalHits(false))
+			}
+			var err error
+			response, err = repository.Client.Search(options...)
 			if err != nil {
 				return err
 			}
@@ -283,6 +290,32 @@ func (repository *baseRepository) SearchWithSize(ctx context.Context, query map[
 	return repository.parseElasticsearchResponse(response)
 }
 
+// Suggest runs a completion suggester on field, options with the same text are skipped by elastic
+func (repository *baseRepository) Suggest(ctx context.Context, field string, prefix string, size int) ([]*elastic.SuggestOption, error) {
+	query := map[string]interface{}{
+		"_source": false,
+		"suggest": elastic.EsObject{
+			suggestionName: elastic.EsObject{
+				"prefix": prefix,
+				"completion": elastic.EsObject{
+					"field":           field,
+					"size":            size,
+					"skip_duplicates": true,
+				},
+			},
+		},
+	}
+	searchResponse, err := repository.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	options := make([]*elastic.SuggestOption, 0, size)
+	for _, entry := range searchResponse.Suggest[suggestionName] {
+		options = append(options, entry.Options...)
+	}
+	return options, nil
+}
+
 func (repository *baseRepository) scrollSearch(ctx context.Context, query map[string]interface{}, size int, duration time.Duration) (*elastic.SearchResponse, error) {
 	var response *esapi.Response
 	err := retry.Do(
@@ -346,6 +379,16 @@ func (repository *baseRepository) scrolling(ctx context.Context, scrollId string
 	return repository.parseElasticsearchResponse(response)
 }
 
+// clearScroll releases the scroll context, a failure is ignored since the context expires anyway.
+// The response is closed so its pooled buffer goes back to the transport.
+func (repository *baseRepository) clearScroll(scrollId string) {
+	response, err := repository.Client.ClearScroll(repository.Client.ClearScroll.WithScrollID(scrollId))
+	if err != nil {
+		return
+	}
+	_ = response.Body.Close()
+}
+
 func (repository *baseRepository) parseElasticsearchResponse(res *esapi.Response) (*elastic.SearchResponse, error) {
 	defer res.Body.Close()
 	if res.IsError() {
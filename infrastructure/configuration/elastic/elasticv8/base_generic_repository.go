@@ -5,6 +5,7 @@ import (
 	"github.com/avast/retry-go"
 	"github.com/elastic/go-elasticsearch/v8"
 	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/elastic/go-elasticsearch/v8/esutil"
 	"presentation-advert-read-api/infrastructure/configuration/custom_error"
 	"presentation-advert-read-api/infrastructure/configuration/custom_json"
 	"presentation-advert-read-api/infrastructure/configuration/elastic"
@@ -32,14 +33,15 @@ func NewBaseGenericRepository[ID comparable, T any](
 	}
 }
 
-func (repository *baseGenericRepository[ID, T]) GetById(ctx context.Context, documentId string, routingId string) (*T, error) {
+func (repository *baseGenericRepository[ID, T]) GetById(ctx context.Context, documentId string, routingId string, sourceIncludes ...string) (*T, error) {
 	var document elastic.SearchHit
 	err := retry.Do(
 		func() error {
 			req := esapi.GetRequest{
-				Index:      repository.IndexName,
-				DocumentID: documentId,
-				Routing:    routingId,
+				Index:          repository.IndexName,
+				DocumentID:     documentId,
+				Routing:        routingId,
+				SourceIncludes: sourceIncludes,
 			}
 			response, err := req.Do(ctx, repository.Client)
 			if err != nil {
@@ -72,6 +74,52 @@ func (repository *baseGenericRepository[ID, T]) GetById(ctx context.Context, doc
 	return result, err
 }
 
+// GetByIds returns the documents in the order of documentIds, missing documents are left as nil
+func (repository *baseGenericRepository[ID, T]) GetByIds(ctx context.Context, documentIds []string, sourceIncludes ...string) ([]*T, error) {
+	results := make([]*T, len(documentIds))
+	if len(documentIds) == 0 {
+		return results, nil
+	}
+	var multiGetResponse elastic.MultiGetResponse
+	err := retry.Do(
+		func() error {
+			multiGetResponse = elastic.MultiGetResponse{}
+			response, err := repository.Client.Mget(
+				esutil.NewJSONReader(elastic.EsObject{"ids": documentIds}),
+				repository.Client.Mget.WithContext(ctx),
+				repository.Client.Mget.WithIndex(repository.IndexName),
+				repository.Client.Mget.WithSourceIncludes(sourceIncludes...),
+			)
+			if err != nil {
+				return err
+			}
+			defer response.Body.Close()
+			if response.IsError() {
+				return custom_error.InternalServerErrWithArgs("GetByIds, %s Index returned an error with status code: %d", repository.IndexName, response.StatusCode)
+			}
+			return custom_json.Decode(response.Body, &multiGetResponse)
+		},
+		retry.Context(ctx),
+		retry.RetryIf(isRetryable),
+		retry.Attempts(5),
+		retry.LastErrorOnly(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for i, document := range multiGetResponse.Docs {
+		if i >= len(results) || !document.Found {
+			continue
+		}
+		_, result, err := repository.mapFunc(document)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = result
+	}
+	return results, nil
+}
+
 func (repository *baseGenericRepository[ID, T]) GetSearchHits(ctx context.Context, query map[string]interface{}) (map[ID]*T, error) {
 	searchResponse, err := repository.Search(ctx, query)
 	if err != nil {
@@ -104,7 +152,7 @@ func (repository *baseGenericRepository[ID, T]) GetIdsChannel(ctx context.Contex
 		}
 		scrollId := searchResponse.ScrollId
 		defer func() {
-			_, _ = repository.Client.ClearScroll(repository.Client.ClearScroll.WithScrollID(scrollId))
+			repository.clearScroll(scrollId)
 		}()
 		for {
 			ids, err := repository.mapToIds(searchResponse)
@@ -112,7 +160,13 @@ func (repository *baseGenericRepository[ID, T]) GetIdsChannel(ctx context.Contex
 				errChan <- err
 				return
 			}
-			idsChan <- ids
+			// stop scrolling when the consumer is gone, the deferred clear scroll releases the context
+			select {
+			case idsChan <- ids:
+			case <-ctx.Done():
+				errChan <- ctx.Err()
+				return
+			}
 			if len(ids) < scrollSize {
 				return
 			}
@@ -147,7 +201,7 @@ func (repository *baseGenericRepository[ID, T]) GetSearchHitsChannel(ctx context
 		}
 		scrollId := searchResponse.ScrollId
 		defer func() {
-			_, _ = repository.Client.ClearScroll(repository.Client.ClearScroll.WithScrollID(scrollId))
+			repository.clearScroll(scrollId)
 		}()
 		for {
 			searchHitMap, err := repository.mapResponse(searchResponse)
@@ -155,7 +209,13 @@ func (repository *baseGenericRepository[ID, T]) GetSearchHitsChannel(ctx context
 				errChan <- err
 				return
 			}
-			searchHitMapChan <- searchHitMap
+			// stop scrolling when the consumer is gone, the deferred clear scroll releases the context
+			select {
+			case searchHitMapChan <- searchHitMap:
+			case <-ctx.Done():
+				errChan <- ctx.Err()
+				return
+			}
 			if len(searchResponse.Hits.Hits) < scrollSize {
 				return
 			}
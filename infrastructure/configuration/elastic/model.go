@@ -64,18 +64,36 @@ type GetByDocIdResponse struct {
 	Found   bool            `json:"found"`
 }
 
+type MultiGetResponse struct {
+	Docs []*SearchHit `json:"docs"`
+}
+
 type CountResponse struct {
 	Shards *ShardsInfo `json:"_shards,omitempty"`
 	Count  int64       `json:"count"`
 }
 
 type SearchResponse struct {
-	Hits         *SearchHits         `json:"hits,omitempty"`
-	Shards       *ShardsInfo         `json:"_shards,omitempty"`
-	Aggregations AggregateDictionary `json:"aggregations,omitempty"`
-	ScrollId     string              `json:"_scroll_id,omitempty"`
-	TookInMillis int64               `json:"took,omitempty"`
-	TimedOut     bool                `json:"timed_out,omitempty"`
+	Hits         *SearchHits                `json:"hits,omitempty"`
+	Shards       *ShardsInfo                `json:"_shards,omitempty"`
+	Aggregations AggregateDictionary        `json:"aggregations,omitempty"`
+	Suggest      map[string][]*SuggestEntry `json:"suggest,omitempty"`
+	ScrollId     string                     `json:"_scroll_id,omitempty"`
+	TookInMillis int64                      `json:"took,omitempty"`
+	TimedOut     bool                       `json:"timed_out,omitempty"`
+}
+
+type SuggestEntry struct {
+	Text    string           `json:"text"`
+	Offset  int              `json:"offset"`
+	Length  int              `json:"length"`
+	Options []*SuggestOption `json:"options"`
+}
+
+type SuggestOption struct {
+	Text  string  `json:"text"`
+	Id    string  `json:"_id,omitempty"`
+	Score float64 `json:"_score"`
 }
 
 type ShardsInfo struct {
@@ -96,12 +114,13 @@ type ShardFailure struct {
 }
 
 type SearchHit struct {
-	Version *int            `json:"_version,omitempty"`
-	Id      string          `json:"_id"`
-	Routing string          `json:"_routing"`
-	Source  json.RawMessage `json:"_source"`
-	Score   float32         `json:"_score"`
-	Found   bool            `json:"found"`
+	Version *int              `json:"_version,omitempty"`
+	Id      string            `json:"_id"`
+	Routing string            `json:"_routing"`
+	Source  json.RawMessage   `json:"_source"`
+	Sort    []json.RawMessage `json:"sort,omitempty"`
+	Score   float32           `json:"_score"`
+	Found   bool              `json:"found"`
 }
 
 type SearchHits struct {
@@ -138,8 +157,9 @@ type TermsAggregate struct {
 
 type TermsAggregateBucket struct {
 	AggregateDictionary
-	Key      interface{}
-	DocCount int64
+	Key         interface{}
+	KeyAsString string
+	DocCount    int64
 }
 
 func (a *TermsAggregateBucket) UnmarshalJSON(data []byte) error {
@@ -153,6 +173,11 @@ func (a *TermsAggregateBucket) UnmarshalJSON(data []byte) error {
 			return err
 		}
 	}
+	if keyAsString, found := aggregateDictionary["key_as_string"]; found && keyAsString != nil {
+		if err := custom_json.Unmarshal(keyAsString, &a.KeyAsString); err != nil {
+			return err
+		}
+	}
 	if docCount, found := aggregateDictionary["doc_count"]; found && docCount != nil {
 		if err := custom_json.Unmarshal(docCount, &a.DocCount); err != nil {
 			return err
@@ -174,7 +199,8 @@ func (a *TopHitsAggregate) UnmarshalJSON(data []byte) error {
 	a.AggregateDictionary = aggregateDictionary
 	a.Hits = new(SearchResponse)
 	if hits, found := aggregateDictionary["hits"]; found && hits != nil {
-		if err := custom_json.Unmarshal(hits, &a.Hits); err != nil {
+		// top_hits body has the same shape as a search response, {"hits": {"total": ..., "hits": [...]}}
+		if err := custom_json.Unmarshal(data, a.Hits); err != nil {
 			return err
 		}
 	}
@@ -253,7 +279,11 @@ type ClusterShardHealth struct {
 	UnassignedShards   int    `json:"unassigned_shards"`
 }
 
+// RangeSearchProperties are the bounds of a range clause on Field, a nil partition leaves that side open
 type RangeSearchProperties struct {
+	Field          string
 	StartPartition interface{}
+	StartExclusive bool
 	EndPartition   interface{}
+	EndExclusive   bool
 }
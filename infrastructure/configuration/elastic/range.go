@@ -0,0 +1,21 @@
+package elastic
+
+// RangeQuery builds the range clause of the properties
+func RangeQuery(properties *RangeSearchProperties) EsObject {
+	bounds := EsObject{}
+	if properties.StartPartition != nil {
+		if properties.StartExclusive {
+			bounds["gt"] = properties.StartPartition
+		} else {
+			bounds["gte"] = properties.StartPartition
+		}
+	}
+	if properties.EndPartition != nil {
+		if properties.EndExclusive {
+			bounds["lt"] = properties.EndPartition
+		} else {
+			bounds["lte"] = properties.EndPartition
+		}
+	}
+	return EsObject{"range": EsObject{properties.Field: bounds}}
+}
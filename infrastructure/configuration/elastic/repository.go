@@ -14,11 +14,13 @@ type BaseRepository interface {
 	DeleteDocuments(ctx context.Context, documents []*DeleteDocument) error
 	Search(ctx context.Context, query map[string]interface{}) (*SearchResponse, error)
 	SearchWithSize(ctx context.Context, query map[string]interface{}, size int) (*SearchResponse, error)
+	Suggest(ctx context.Context, field string, prefix string, size int) ([]*SuggestOption, error)
 }
 
 type BaseGenericRepository[ID comparable, T any] interface {
 	BaseRepository
-	GetById(ctx context.Context, documentId string, routingId string) (*T, error)
+	GetById(ctx context.Context, documentId string, routingId string, sourceIncludes ...string) (*T, error)
+	GetByIds(ctx context.Context, documentIds []string, sourceIncludes ...string) ([]*T, error)
 	GetSearchHits(ctx context.Context, query map[string]interface{}) (map[ID]*T, error)
 	GetSearchHitsChannel(ctx context.Context, query map[string]interface{}, scrollSize int, scrollDuration time.Duration) (<-chan map[ID]*T, <-chan error)
 	GetSearchHitsUsingScroll(ctx context.Context, query map[string]interface{}, scrollSize int, scrollDuration time.Duration) (map[ID]*T, error)
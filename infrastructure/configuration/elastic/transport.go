@@ -3,10 +3,11 @@ package elastic
 import (
 	"bytes"
 	"compress/gzip"
+	"errors"
 	"github.com/valyala/fasthttp"
 	"io"
 	"net/http"
-	"strings"
+	"sync"
 )
 
 type transport struct {
@@ -30,22 +31,27 @@ func NewTransport(elasticConfig *Config) *transport {
 	return &transport{client: client}
 }
 
-// RoundTrip performs the request and returns a response or error
+// RoundTrip performs the request and returns a response or error, the fasthttp response is released when the body is closed
 func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	freq := fasthttp.AcquireRequest()
 	defer fasthttp.ReleaseRequest(freq)
 
 	fastHttpResponse := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseResponse(fastHttpResponse)
 
 	t.copyRequest(freq, req)
 
 	err := t.client.Do(freq, fastHttpResponse)
 	if err != nil {
+		fasthttp.ReleaseResponse(fastHttpResponse)
 		return nil, err
 	}
 
-	return t.toHttpResponse(fastHttpResponse)
+	response, err := t.toHttpResponse(fastHttpResponse)
+	if err != nil {
+		fasthttp.ReleaseResponse(fastHttpResponse)
+		return nil, err
+	}
+	return response, nil
 }
 
 // copyRequest converts a http.Request to fasthttp.Request
@@ -72,7 +78,8 @@ func (t *transport) copyRequest(dst *fasthttp.Request, src *http.Request) *fasth
 	return dst
 }
 
-// toHttpResponse converts fasthttp.Response to http.Response
+// toHttpResponse converts fasthttp.Response to http.Response. The body is read from the pooled buffer of src
+// without copying it, a gzip body is decompressed while it is read, so src must not be released before the body is closed.
 func (t *transport) toHttpResponse(src *fasthttp.Response) (*http.Response, error) {
 	response := &http.Response{Header: make(http.Header)}
 	response.StatusCode = src.StatusCode()
@@ -81,32 +88,88 @@ func (t *transport) toHttpResponse(src *fasthttp.Response) (*http.Response, erro
 		response.Header.Set(string(k), string(v))
 	})
 
-	responseStr := string(src.Body())
-
-	contentEncoding := response.Header.Get("Content-Encoding")
-
-	if contentEncoding == "gzip" {
-		compressedBuffer := bytes.NewReader(src.Body())
-
-		gzipReader, err := gzip.NewReader(compressedBuffer)
+	body := &responseBody{response: src}
+	body.reader.Reset(src.Body())
+	response.ContentLength = int64(len(src.Body()))
 
+	// decompressed like net/http does when it asks for gzip itself
+	if response.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := acquireGzipReader(&body.reader)
 		if err != nil {
 			return nil, err
 		}
+		body.gzipReader = gzipReader
+		response.Header.Del("Content-Encoding")
+		response.Header.Del("Content-Length")
+		response.ContentLength = -1
+		response.Uncompressed = true
+	}
+
+	response.Body = body
+	return response, nil
+}
 
-		uncompressedData := new(bytes.Buffer)
-		_, err = uncompressedData.ReadFrom(gzipReader)
+const copyBufferSize = 32 * 1024
 
-		if err != nil {
+var (
+	gzipReaderPool  sync.Pool
+	copyBufferPool  = sync.Pool{New: func() any { buffer := make([]byte, copyBufferSize); return &buffer }}
+	errBodyIsClosed = errors.New("read on closed response body")
+)
+
+func acquireGzipReader(reader io.Reader) (*gzip.Reader, error) {
+	if gzipReader, ok := gzipReaderPool.Get().(*gzip.Reader); ok {
+		if err := gzipReader.Reset(reader); err != nil {
+			gzipReaderPool.Put(gzipReader)
 			return nil, err
 		}
+		return gzipReader, nil
+	}
+	return gzip.NewReader(reader)
+}
 
-		responseStr = uncompressedData.String()
+// responseBody reads the body of a pooled fasthttp response and gives the response back to the pool on Close
+type responseBody struct {
+	response   *fasthttp.Response
+	reader     bytes.Reader
+	gzipReader *gzip.Reader
+	closed     bool
+}
+
+func (body *responseBody) Read(p []byte) (int, error) {
+	if body.closed {
+		return 0, errBodyIsClosed
+	}
+	if body.gzipReader != nil {
+		return body.gzipReader.Read(p)
 	}
+	return body.reader.Read(p)
+}
 
-	// Cast to a string to make a copy seeing as src.Body() won't
-	// be valid after the response is released back to the pool (fasthttp.ReleaseResponse).
-	response.Body = io.NopCloser(strings.NewReader(responseStr))
+// WriteTo lets io.Copy and bytes.Buffer.ReadFrom take the uncompressed body without an intermediate buffer
+func (body *responseBody) WriteTo(w io.Writer) (int64, error) {
+	if body.closed {
+		return 0, errBodyIsClosed
+	}
+	if body.gzipReader != nil {
+		buffer := copyBufferPool.Get().(*[]byte)
+		defer copyBufferPool.Put(buffer)
+		return io.CopyBuffer(w, body.gzipReader, *buffer)
+	}
+	return body.reader.WriteTo(w)
+}
 
-	return response, nil
+func (body *responseBody) Close() error {
+	if body.closed {
+		return nil
+	}
+	body.closed = true
+	if body.gzipReader != nil {
+		gzipReaderPool.Put(body.gzipReader)
+		body.gzipReader = nil
+	}
+	body.reader.Reset(nil)
+	fasthttp.ReleaseResponse(body.response)
+	body.response = nil
+	return nil
 }
@@ -0,0 +1,224 @@
+package elastic
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttputil"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// BenchmarkTransport reads a search page through the transport and through the former
+// copying conversion, which turned the body into a string and inflated gzip into another buffer
+func BenchmarkTransport(b *testing.B) {
+	body := searchResponseBody(500)
+	for _, compressed := range []bool{false, true} {
+		listener := serveBody(b, body, compressed)
+		client := &fasthttp.Client{Dial: func(string) (net.Conn, error) { return listener.Dial() }}
+		name := "plain"
+		if compressed {
+			name = "gzip"
+		}
+		b.Run(name+"/pooled", func(b *testing.B) {
+			t := &transport{client: client}
+			benchmarkRoundTrip(b, len(body), t.RoundTrip)
+		})
+		b.Run(name+"/copied", func(b *testing.B) {
+			benchmarkRoundTrip(b, len(body), func(req *http.Request) (*http.Response, error) {
+				return copyingRoundTrip(client, req)
+			})
+		})
+	}
+}
+
+func benchmarkRoundTrip(b *testing.B, bodySize int, roundTrip func(req *http.Request) (*http.Response, error)) {
+	b.ReportAllocs()
+	b.SetBytes(int64(bodySize))
+	for i := 0; i < b.N; i++ {
+		request, _ := http.NewRequest(http.MethodGet, "http://elastic/adverts/_search", nil)
+		response, err := roundTrip(request)
+		if err != nil {
+			b.Fatal(err)
+		}
+		read, err := io.Copy(io.Discard, response.Body)
+		if err != nil || int(read) != bodySize {
+			b.Fatalf("read %d bytes of %d, err: %v", read, bodySize, err)
+		}
+		_ = response.Body.Close()
+	}
+}
+
+// copyingRoundTrip is the former conversion the benchmark compares against
+func copyingRoundTrip(client *fasthttp.Client, req *http.Request) (*http.Response, error) {
+	freq := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(freq)
+	fastHttpResponse := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(fastHttpResponse)
+	(&transport{}).copyRequest(freq, req)
+	if err := client.Do(freq, fastHttpResponse); err != nil {
+		return nil, err
+	}
+	response := &http.Response{Header: make(http.Header), StatusCode: fastHttpResponse.StatusCode()}
+	fastHttpResponse.Header.VisitAll(func(k, v []byte) {
+		response.Header.Set(string(k), string(v))
+	})
+	responseStr := string(fastHttpResponse.Body())
+	if response.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(bytes.NewReader(fastHttpResponse.Body()))
+		if err != nil {
+			return nil, err
+		}
+		uncompressedData := new(bytes.Buffer)
+		if _, err = uncompressedData.ReadFrom(gzipReader); err != nil {
+			return nil, err
+		}
+		responseStr = uncompressedData.String()
+	}
+	response.Body = io.NopCloser(strings.NewReader(responseStr))
+	return response, nil
+}
+
+func serveBody(b *testing.B, body []byte, compressed bool) *fasthttputil.InmemoryListener {
+	responseBody := body
+	if compressed {
+		responseBody = gzipBody(body)
+	}
+	listener := fasthttputil.NewInmemoryListener()
+	server := &fasthttp.Server{Handler: func(ctx *fasthttp.RequestCtx) {
+		ctx.SetContentType("application/json")
+		if compressed {
+			ctx.Response.Header.Set("Content-Encoding", "gzip")
+		}
+		ctx.SetBody(responseBody)
+	}}
+	go func() { _ = server.Serve(listener) }()
+	b.Cleanup(func() { _ = listener.Close() })
+	return listener
+}
+
+func searchResponseBody(size int) []byte {
+	var buffer bytes.Buffer
+	buffer.WriteString(`{"took":3,"timed_out":false,"hits":{"total":{"value":10000,"relation":"gte"},"hits":[`)
+	for i := 0; i < size; i++ {
+		if i > 0 {
+			buffer.WriteByte(',')
+		}
+		fmt.Fprintf(&buffer, `{"_index":"adverts","_id":"%d","_score":1.0,"_source":{"id":%d,"title":"Satılık 3+1 daire %d","description":"%s","category":{"id":%d,"name":"Satılık Daire"}}}`,
+			i, i, i, strings.Repeat("Metroya yürüme mesafesinde, güney cepheli. ", 8), i%10)
+	}
+	buffer.WriteString(`]}}`)
+	return buffer.Bytes()
+}
+
+func gzipBody(body []byte) []byte {
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	_, _ = gzipWriter.Write(body)
+	_ = gzipWriter.Close()
+	return buffer.Bytes()
+}
+
+// newResponse converts a pooled fasthttp response with body like RoundTrip does
+func newResponse(t *testing.T, body []byte, compressed bool) *http.Response {
+	t.Helper()
+	fastHttpResponse := fasthttp.AcquireResponse()
+	if compressed {
+		fastHttpResponse.Header.Set("Content-Encoding", "gzip")
+		body = gzipBody(body)
+	}
+	fastHttpResponse.SetBody(body)
+	response, err := (&transport{}).toHttpResponse(fastHttpResponse)
+	if err != nil {
+		fasthttp.ReleaseResponse(fastHttpResponse)
+		t.Fatal(err)
+	}
+	return response
+}
+
+func TestResponseBody_Read(t *testing.T) {
+	body := searchResponseBody(50)
+	for _, compressed := range []bool{false, true} {
+		response := newResponse(t, body, compressed)
+		if compressed && (response.Header.Get("Content-Encoding") != "" || response.ContentLength != -1 || !response.Uncompressed) {
+			t.Fatalf("a gzip response keeps Content-Encoding %q and ContentLength %d", response.Header.Get("Content-Encoding"), response.ContentLength)
+		}
+		if !compressed && response.ContentLength != int64(len(body)) {
+			t.Fatalf("ContentLength is %d, want %d", response.ContentLength, len(body))
+		}
+		read, err := io.ReadAll(response.Body)
+		if err != nil || !bytes.Equal(read, body) {
+			t.Fatalf("compressed %v: read %d bytes of %d, err: %v", compressed, len(read), len(body), err)
+		}
+		if err := response.Body.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestResponseBody_WriteTo(t *testing.T) {
+	body := searchResponseBody(50)
+	for _, compressed := range []bool{false, true} {
+		response := newResponse(t, body, compressed)
+		var buffer bytes.Buffer
+		if _, err := buffer.ReadFrom(response.Body); err != nil || !bytes.Equal(buffer.Bytes(), body) {
+			t.Fatalf("compressed %v: wrote %d bytes of %d, err: %v", compressed, buffer.Len(), len(body), err)
+		}
+		_ = response.Body.Close()
+	}
+}
+
+func TestResponseBody_ReadAfterClose(t *testing.T) {
+	for _, compressed := range []bool{false, true} {
+		response := newResponse(t, []byte(`{"took":1}`), compressed)
+		_ = response.Body.Close()
+		if _, err := response.Body.Read(make([]byte, 8)); err != errBodyIsClosed {
+			t.Fatalf("compressed %v: Read after Close returned %v", compressed, err)
+		}
+		if _, err := io.Copy(io.Discard, response.Body); err != errBodyIsClosed {
+			t.Fatalf("compressed %v: WriteTo after Close returned %v", compressed, err)
+		}
+	}
+}
+
+func TestResponseBody_DoubleClose(t *testing.T) {
+	response := newResponse(t, []byte(`{"took":1}`), true)
+	body := response.Body.(*responseBody)
+	gzipReader := body.gzipReader
+	if err := response.Body.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := response.Body.Close(); err != nil {
+		t.Fatalf("the second Close returned %v", err)
+	}
+
+	// a reader put back twice would be handed out to two bodies at once
+	first, _ := gzipReaderPool.Get().(*gzip.Reader)
+	second, _ := gzipReaderPool.Get().(*gzip.Reader)
+	if first == gzipReader && second == gzipReader {
+		t.Fatal("the gzip reader was put back into the pool twice")
+	}
+}
+
+func TestResponseBody_CloseWhilePartiallyRead(t *testing.T) {
+	body := searchResponseBody(50)
+	response := newResponse(t, body, true)
+	if _, err := io.ReadFull(response.Body, make([]byte, 100)); err != nil {
+		t.Fatal(err)
+	}
+	if err := response.Body.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the pooled gzip reader of the closed body must read the next body from its start
+	next := newResponse(t, body, true)
+	defer next.Body.Close()
+	read, err := io.ReadAll(next.Body)
+	if err != nil || !bytes.Equal(read, body) {
+		t.Fatalf("read %d bytes of %d after an early Close, err: %v", len(read), len(body), err)
+	}
+}
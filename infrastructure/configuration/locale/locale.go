@@ -0,0 +1,142 @@
+package locale
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	LanguageParam        = "lang"
+	AcceptLanguageHeader = "Accept-Language"
+)
+
+// languagePattern is the shape of a BCP 47 tag, a language subtag followed by region, script or variant subtags
+var languagePattern = regexp.MustCompile(`^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$`)
+
+type languagesKey struct{}
+
+// Middleware puts the language chain of the request into its context
+func Middleware(config *Config) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Response().Header().Add(echo.HeaderVary, AcceptLanguageHeader)
+			language := c.QueryParam(LanguageParam)
+			if language != "" && !IsLanguage(language) {
+				return custom_error.BadRequestErrWithArgs("%s must be a language tag like en or en-US", LanguageParam)
+			}
+			request := c.Request()
+			languages := Chain(config, language, request.Header.Get(AcceptLanguageHeader))
+			c.SetRequest(request.WithContext(WithLanguages(request.Context(), languages)))
+			return next(c)
+		}
+	}
+}
+
+// Chain returns the languages to resolve names with, language takes precedence over acceptLanguage.
+// Languages of acceptLanguage that are not language tags are skipped.
+func Chain(config *Config, language string, acceptLanguage string) []string {
+	var languages []string
+	if language != "" {
+		languages = withBaseLanguages([]string{language})
+	} else {
+		languages = parseAcceptLanguage(acceptLanguage)
+	}
+	for _, fallback := range config.Fallbacks {
+		languages = appendLanguage(languages, fallback)
+	}
+	return languages
+}
+
+// IsLanguage reports whether language looks like a BCP 47 language tag
+func IsLanguage(language string) bool {
+	return languagePattern.MatchString(language)
+}
+
+func WithLanguages(ctx context.Context, languages []string) context.Context {
+	return context.WithValue(ctx, languagesKey{}, languages)
+}
+
+// Languages returns the language chain of the request, it is empty outside of http requests
+func Languages(ctx context.Context) []string {
+	languages, _ := ctx.Value(languagesKey{}).([]string)
+	return languages
+}
+
+// ContextKey identifies the language chain of ctx, results with localized names differ by it
+func ContextKey(ctx context.Context) string {
+	return strings.Join(Languages(ctx), ",")
+}
+
+// Name returns the name in the first language of the chain that has one, defaultName is used when none has
+func Name(ctx context.Context, defaultName string, names map[string]string) string {
+	if len(names) == 0 {
+		return defaultName
+	}
+	for _, language := range Languages(ctx) {
+		if name, exists := names[language]; exists && name != "" {
+			return name
+		}
+	}
+	return defaultName
+}
+
+// parseAcceptLanguage orders the languages by quality, a regional language is followed by its base language
+func parseAcceptLanguage(header string) []string {
+	type weightedLanguage struct {
+		language string
+		quality  float64
+	}
+	weightedLanguages := make([]weightedLanguage, 0)
+	for _, part := range strings.Split(header, ",") {
+		language, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		language = strings.TrimSpace(language)
+		if !IsLanguage(language) {
+			continue
+		}
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality > 0 {
+			weightedLanguages = append(weightedLanguages, weightedLanguage{language: language, quality: quality})
+		}
+	}
+	sort.SliceStable(weightedLanguages, func(i, j int) bool {
+		return weightedLanguages[i].quality > weightedLanguages[j].quality
+	})
+	languages := make([]string, 0, len(weightedLanguages))
+	for _, weighted := range weightedLanguages {
+		languages = append(languages, weighted.language)
+	}
+	return withBaseLanguages(languages)
+}
+
+func withBaseLanguages(languages []string) []string {
+	chain := make([]string, 0, len(languages))
+	for _, language := range languages {
+		chain = appendLanguage(chain, language)
+		if base, _, regional := strings.Cut(language, "-"); regional {
+			chain = appendLanguage(chain, base)
+		}
+	}
+	return chain
+}
+
+func appendLanguage(languages []string, language string) []string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	for _, existing := range languages {
+		if existing == language {
+			return languages
+		}
+	}
+	return append(languages, language)
+}
@@ -0,0 +1,6 @@
+package locale
+
+type Config struct {
+	// Fallbacks are tried in order after the languages of the request
+	Fallbacks []string `json:"fallbacks"`
+}
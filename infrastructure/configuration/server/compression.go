@@ -0,0 +1,188 @@
+package server
+
+import (
+	"bufio"
+	"compress/gzip"
+	"github.com/andybalholm/brotli"
+	"github.com/labstack/echo/v4"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+var (
+	gzipWriterPool   = sync.Pool{New: func() any { return gzip.NewWriter(io.Discard) }}
+	brotliWriterPool = sync.Pool{New: func() any { return brotli.NewWriterLevel(io.Discard, brotli.DefaultCompression) }}
+)
+
+// CompressionMiddleware compresses responses of at least minSize bytes with brotli or gzip, whichever the client prefers.
+// Smaller responses are written as they are, compressing them costs more than it saves.
+func CompressionMiddleware(minSize int) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			response := c.Response()
+			response.Header().Add(echo.HeaderVary, echo.HeaderAcceptEncoding)
+			encoding := negotiateEncoding(c.Request().Header.Get(echo.HeaderAcceptEncoding))
+			if encoding == "" || c.Request().Method == http.MethodHead {
+				return next(c)
+			}
+			writer := &compressionWriter{ResponseWriter: response.Writer, encoding: encoding, minSize: minSize}
+			response.Writer = writer
+			defer func() {
+				writer.close()
+				response.Writer = writer.ResponseWriter
+			}()
+			return next(c)
+		}
+	}
+}
+
+// negotiateEncoding picks the supported encoding with the highest quality, brotli wins a tie
+func negotiateEncoding(acceptEncoding string) string {
+	qualities := make(map[string]float64)
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		encoding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		quality := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		qualities[strings.ToLower(encoding)] = quality
+	}
+	encoding, bestQuality := "", 0.0
+	for _, candidate := range []string{encodingBrotli, encodingGzip} {
+		quality, exists := qualities[candidate]
+		if !exists {
+			quality, exists = qualities["*"]
+		}
+		if exists && quality > bestQuality {
+			encoding, bestQuality = candidate, quality
+		}
+	}
+	return encoding
+}
+
+// compressionWriter buffers the response until minSize bytes are written, then it decides on compression
+type compressionWriter struct {
+	http.ResponseWriter
+	encoding   string
+	minSize    int
+	statusCode int
+	buffer     []byte
+	compressor io.WriteCloser
+	// decided is set when the response is compressed or written as it is
+	decided bool
+}
+
+func (writer *compressionWriter) WriteHeader(statusCode int) {
+	writer.statusCode = statusCode
+}
+
+func (writer *compressionWriter) Write(data []byte) (int, error) {
+	if writer.decided {
+		if writer.compressor != nil {
+			return writer.compressor.Write(data)
+		}
+		return writer.ResponseWriter.Write(data)
+	}
+	writer.buffer = append(writer.buffer, data...)
+	if len(writer.buffer) >= writer.minSize {
+		if err := writer.decide(true); err != nil {
+			return 0, err
+		}
+	}
+	return len(data), nil
+}
+
+// Flush starts compressing a streamed response, its size is not known in advance
+func (writer *compressionWriter) Flush() {
+	if !writer.decided {
+		_ = writer.decide(len(writer.buffer) > 0)
+	}
+	if flusher, ok := writer.compressor.(interface{ Flush() error }); ok {
+		_ = flusher.Flush()
+	}
+	if flusher, ok := writer.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (writer *compressionWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return http.NewResponseController(writer.ResponseWriter).Hijack()
+}
+
+func (writer *compressionWriter) Unwrap() http.ResponseWriter {
+	return writer.ResponseWriter
+}
+
+func (writer *compressionWriter) decide(compress bool) error {
+	writer.decided = true
+	header := writer.ResponseWriter.Header()
+	statusCode := writer.statusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	// a response that is encoded already, like the metrics, or has no body is not compressed
+	if compress && header.Get(echo.HeaderContentEncoding) == "" && statusCode != http.StatusNoContent && statusCode != http.StatusNotModified {
+		header.Set(echo.HeaderContentEncoding, writer.encoding)
+		header.Del(echo.HeaderContentLength)
+		writer.compressor = writer.newCompressor()
+	}
+	writer.ResponseWriter.WriteHeader(statusCode)
+	buffer := writer.buffer
+	writer.buffer = nil
+	if len(buffer) == 0 {
+		return nil
+	}
+	if writer.compressor != nil {
+		_, err := writer.compressor.Write(buffer)
+		return err
+	}
+	_, err := writer.ResponseWriter.Write(buffer)
+	return err
+}
+
+func (writer *compressionWriter) newCompressor() io.WriteCloser {
+	if writer.encoding == encodingBrotli {
+		brotliWriter := brotliWriterPool.Get().(*brotli.Writer)
+		brotliWriter.Reset(writer.ResponseWriter)
+		return brotliWriter
+	}
+	gzipWriter := gzipWriterPool.Get().(*gzip.Writer)
+	gzipWriter.Reset(writer.ResponseWriter)
+	return gzipWriter
+}
+
+// close writes a response smaller than minSize as it is and finishes a compressed one
+func (writer *compressionWriter) close() {
+	if !writer.decided {
+		if writer.statusCode == 0 && len(writer.buffer) == 0 {
+			return
+		}
+		_ = writer.decide(false)
+	}
+	if writer.compressor == nil {
+		return
+	}
+	_ = writer.compressor.Close()
+	switch compressor := writer.compressor.(type) {
+	case *brotli.Writer:
+		compressor.Reset(io.Discard)
+		brotliWriterPool.Put(compressor)
+	case *gzip.Writer:
+		compressor.Reset(io.Discard)
+		gzipWriterPool.Put(compressor)
+	}
+	writer.compressor = nil
+}
@@ -0,0 +1,189 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"github.com/andybalholm/brotli"
+	"github.com/labstack/echo/v4"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{acceptEncoding: "", want: ""},
+		{acceptEncoding: "identity", want: ""},
+		{acceptEncoding: "identity;q=0", want: ""},
+		{acceptEncoding: "identity;q=0, gzip", want: encodingGzip},
+		{acceptEncoding: "gzip", want: encodingGzip},
+		{acceptEncoding: "GZIP", want: encodingGzip},
+		{acceptEncoding: "gzip, br", want: encodingBrotli},
+		{acceptEncoding: "gzip;q=1.0, br;q=0.5", want: encodingGzip},
+		{acceptEncoding: "gzip; q=0.5, br; q=0.8", want: encodingBrotli},
+		{acceptEncoding: "gzip;q=0", want: ""},
+		{acceptEncoding: "gzip;q=0, br;q=0", want: ""},
+		{acceptEncoding: "gzip;q=invalid", want: ""},
+		{acceptEncoding: "*", want: encodingBrotli},
+		{acceptEncoding: "br;q=0, *", want: encodingGzip},
+		{acceptEncoding: "*;q=0.5, gzip", want: encodingGzip},
+		{acceptEncoding: "deflate", want: ""},
+	}
+	for _, test := range tests {
+		if encoding := negotiateEncoding(test.acceptEncoding); encoding != test.want {
+			t.Errorf("negotiateEncoding(%q) = %q, want %q", test.acceptEncoding, encoding, test.want)
+		}
+	}
+}
+
+func serveCompressed(t *testing.T, method string, acceptEncoding string, handler echo.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	request := httptest.NewRequest(method, "/adverts", nil)
+	request.Header.Set(echo.HeaderAcceptEncoding, acceptEncoding)
+	recorder := httptest.NewRecorder()
+	if err := CompressionMiddleware(100)(handler)(echo.New().NewContext(request, recorder)); err != nil {
+		t.Fatal(err)
+	}
+	return recorder
+}
+
+func writeBody(body string) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderContentLength, strconv.Itoa(len(body)))
+		return c.String(http.StatusOK, body)
+	}
+}
+
+func decode(t *testing.T, encoding string, body []byte) string {
+	t.Helper()
+	var reader io.Reader
+	switch encoding {
+	case encodingGzip:
+		gzipReader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader = gzipReader
+	case encodingBrotli:
+		reader = brotli.NewReader(bytes.NewReader(body))
+	default:
+		return string(body)
+	}
+	decoded, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(decoded)
+}
+
+func TestCompressionMiddleware_BelowMinSize(t *testing.T) {
+	body := strings.Repeat("a", 99)
+	recorder := serveCompressed(t, http.MethodGet, "gzip", writeBody(body))
+
+	if encoding := recorder.Header().Get(echo.HeaderContentEncoding); encoding != "" {
+		t.Fatalf("a response below the min size was encoded with %q", encoding)
+	}
+	if contentLength := recorder.Header().Get(echo.HeaderContentLength); contentLength != "99" {
+		t.Fatalf("Content-Length is %q, want 99", contentLength)
+	}
+	if recorder.Body.String() != body {
+		t.Fatalf("body is %q", recorder.Body.String())
+	}
+}
+
+func TestCompressionMiddleware_AboveMinSize(t *testing.T) {
+	body := strings.Repeat("a", 100)
+	for _, encoding := range []string{encodingGzip, encodingBrotli} {
+		recorder := serveCompressed(t, http.MethodGet, encoding, writeBody(body))
+
+		if contentEncoding := recorder.Header().Get(echo.HeaderContentEncoding); contentEncoding != encoding {
+			t.Fatalf("Content-Encoding is %q, want %q", contentEncoding, encoding)
+		}
+		if contentLength := recorder.Header().Get(echo.HeaderContentLength); contentLength != "" {
+			t.Fatalf("Content-Length %q of the uncompressed body was kept", contentLength)
+		}
+		if decoded := decode(t, encoding, recorder.Body.Bytes()); decoded != body {
+			t.Fatalf("decoded %s body is %q", encoding, decoded)
+		}
+	}
+}
+
+func TestCompressionMiddleware_Vary(t *testing.T) {
+	for _, acceptEncoding := range []string{"gzip", "identity"} {
+		recorder := serveCompressed(t, http.MethodGet, acceptEncoding, writeBody("a"))
+		if vary := recorder.Header().Get(echo.HeaderVary); vary != echo.HeaderAcceptEncoding {
+			t.Fatalf("Vary is %q with Accept-Encoding %q", vary, acceptEncoding)
+		}
+	}
+}
+
+func TestCompressionMiddleware_Head(t *testing.T) {
+	recorder := serveCompressed(t, http.MethodHead, "gzip", func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderContentLength, "1000")
+		return c.NoContent(http.StatusOK)
+	})
+
+	if encoding := recorder.Header().Get(echo.HeaderContentEncoding); encoding != "" {
+		t.Fatalf("a HEAD response was encoded with %q", encoding)
+	}
+	if contentLength := recorder.Header().Get(echo.HeaderContentLength); contentLength != "1000" {
+		t.Fatalf("Content-Length is %q, want 1000", contentLength)
+	}
+}
+
+func TestCompressionMiddleware_NotModified(t *testing.T) {
+	recorder := serveCompressed(t, http.MethodGet, "gzip", func(c echo.Context) error {
+		c.Response().Header().Set("ETag", `W/"1-1"`)
+		return c.NoContent(http.StatusNotModified)
+	})
+
+	if recorder.Code != http.StatusNotModified {
+		t.Fatalf("status is %d, want 304", recorder.Code)
+	}
+	if encoding := recorder.Header().Get(echo.HeaderContentEncoding); encoding != "" {
+		t.Fatalf("a 304 was encoded with %q", encoding)
+	}
+	if recorder.Body.Len() != 0 {
+		t.Fatalf("a 304 has a body of %d bytes", recorder.Body.Len())
+	}
+}
+
+func TestCompressionMiddleware_Flush(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/adverts/export", nil)
+	request.Header.Set(echo.HeaderAcceptEncoding, "gzip")
+	recorder := httptest.NewRecorder()
+	var flushedBeforeEnd []byte
+	handler := CompressionMiddleware(100)(func(c echo.Context) error {
+		response := c.Response()
+		response.WriteHeader(http.StatusOK)
+		if _, err := response.Write([]byte("first line\n")); err != nil {
+			return err
+		}
+		response.Flush()
+		flushedBeforeEnd = bytes.Clone(recorder.Body.Bytes())
+		_, err := response.Write([]byte("second line\n"))
+		return err
+	})
+	if err := handler(echo.New().NewContext(request, recorder)); err != nil {
+		t.Fatal(err)
+	}
+
+	if encoding := recorder.Header().Get(echo.HeaderContentEncoding); encoding != encodingGzip {
+		t.Fatalf("a flushed response below the min size was encoded with %q, want gzip", encoding)
+	}
+	if !recorder.Flushed {
+		t.Fatal("Flush did not reach the underlying writer")
+	}
+	if len(flushedBeforeEnd) == 0 {
+		t.Fatal("nothing was written before the handler returned")
+	}
+	if decoded := decode(t, encodingGzip, recorder.Body.Bytes()); decoded != "first line\nsecond line\n" {
+		t.Fatalf("decoded body is %q", decoded)
+	}
+}
@@ -1,5 +1,8 @@
 package server
 
 type Config struct {
-	Port string `json:"port"`
+	Port     string `json:"port"`
+	GrpcPort string `json:"grpcPort"`
+	// CompressionMinSize is the response size in bytes from which responses are compressed
+	CompressionMinSize int `json:"compressionMinSize"`
 }
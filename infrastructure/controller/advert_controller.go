@@ -2,12 +2,22 @@ package controller
 
 import (
 	"github.com/labstack/echo/v4"
+	"net/http"
 	"presentation-advert-read-api/application/handlers"
 	"presentation-advert-read-api/application/queries"
 	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"presentation-advert-read-api/infrastructure/configuration/custom_json"
+	"presentation-advert-read-api/infrastructure/configuration/log"
+	"presentation-advert-read-api/infrastructure/validation"
+	"presentation-advert-read-api/model/model_api"
 	"strconv"
 )
 
+const (
+	defaultSimilarAdvertSize = 10
+	maxSimilarAdvertSize     = 20
+)
+
 type advertController struct {
 	queryHandler *handlers.QueryHandler
 }
@@ -23,7 +33,13 @@ func NewAdvertController(
 }
 
 func (controller *advertController) register(e *echo.Echo) {
+	e.GET("/adverts", controller.GetAdverts)
+	e.GET("/adverts/_export", controller.ExportAdverts)
+	e.GET("/adverts/_count", controller.CountAdverts)
+	e.GET("/adverts/changes", controller.GetAdvertChanges)
 	e.GET("/adverts/:id", controller.GetAdvertById)
+	e.HEAD("/adverts/:id", controller.AdvertExists)
+	e.GET("/adverts/:id/similar", controller.GetSimilarAdverts)
 
 }
 
@@ -32,7 +48,15 @@ func (controller *advertController) register(e *echo.Echo) {
 // @Accept  json
 // @Produce  json
 // @Param id path string true "id"
+// @Param fields query string false "comma separated fields to return, id is always returned"
+// @Param lang query string false "language tag of the category names like en or en-US, takes precedence over Accept-Language"
+// @Param Accept-Language header string false "preferred languages of the category names"
+// @Param view query string false "default or full, full adds version and audit fields"
+// @Param Prefer header string false "view=full is the same as ?view=full"
+// @Param If-None-Match header string false "etag of the cached response"
+// @Param If-Modified-Since header string false "last modified date of the cached response"
 // @Success  200  {object}  model_api.AdvertResponse
+// @Success  304  "Not Modified"
 // @Failure  400  {object} custom_error.CustomError
 // @Failure  404  {object} custom_error.CustomError
 // @Router /adverts/{id} [get]
@@ -43,9 +67,362 @@ func (controller *advertController) GetAdvertById(c echo.Context) error {
 	if err != nil {
 		return custom_error.BadRequestErr("id must be number")
 	}
-	advertResponse, err := controller.queryHandler.GetAdvert.Handle(ctx, &queries.GetAdvertQuery{Id: id})
+	view, err := viewParam(c)
+	if err != nil {
+		return err
+	}
+	fields, err := queryParamList(c, "fields", viewFields(view, advertFields)...)
+	if err != nil {
+		return err
+	}
+	query := &queries.GetAdvertQuery{Id: id, Fields: fields}
+	if view == fullView {
+		advertFullResponse, err := controller.queryHandler.GetAdvertFull.Handle(ctx, query)
+		if err != nil {
+			return err
+		}
+		if checkNotModified(c, advertFullResponse.Id, advertFullResponse.Version, advertFullResponse.LastModifiedDate, view, fields) {
+			return c.NoContent(http.StatusNotModified)
+		}
+		return jsonWithFields(c, 200, advertFullResponse, fields, "")
+	}
+	advertResponse, err := controller.queryHandler.GetAdvert.Handle(ctx, query)
+	if err != nil {
+		return err
+	}
+	if checkNotModified(c, advertResponse.Id, advertResponse.Version, advertResponse.LastModifiedDate, view, fields) {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return jsonWithFields(c, 200, advertResponse, fields, "")
+}
+
+// GetAdverts godoc
+// @tags adverts
+// @Accept  json
+// @Produce  json
+// @Param ids query string false "comma separated advert ids, when given adverts are fetched by id and model_api.AdvertListResponse is returned"
+// @Param q query string false "text searched in title and description"
+// @Param categoryId query int false "category id"
+// @Param creationDateFrom query string false "inclusive creation date lower bound, yyyy-MM-dd or RFC 3339"
+// @Param creationDateTo query string false "inclusive creation date upper bound, yyyy-MM-dd or RFC 3339"
+// @Param creationDate[gte] query string false "range filter, gte, gt, lte and lt operators are supported on creationDate, lastModifiedDate and version"
+// @Param lat query number false "latitude of the origin, required with lon"
+// @Param lon query number false "longitude of the origin, required with lat"
+// @Param radius query number false "keeps adverts within radius kilometres of the origin"
+// @Param sort query string false "relevance (default) or distance, distance needs lat and lon"
+// @Param facets query string false "comma separated facets to compute, category and creationMonth are supported"
+// @Param fields query string false "comma separated fields to return, id is always returned"
+// @Param lang query string false "language tag of the category names like en or en-US, takes precedence over Accept-Language"
+// @Param Accept-Language header string false "preferred languages of the category names"
+// @Param page query int false "page number, starts from 0, (page+1)*size must be at most 10000"
+// @Param size query int false "page size, max 100"
+// @Success  200  {object}  model_api.AdvertSearchResponse
+// @Failure  400  {object} custom_error.CustomError
+// @Router /adverts [get]
+func (controller *advertController) GetAdverts(c echo.Context) error {
+	if c.QueryParams().Has("ids") {
+		return controller.getAdvertsByIds(c)
+	}
+	return controller.searchAdverts(c)
+}
+
+func (controller *advertController) getAdvertsByIds(c echo.Context) error {
+	ctx := c.Request().Context()
+	ids, err := queryParamIds(c, "ids")
+	if err != nil {
+		return err
+	}
+	fields, err := queryParamList(c, "fields", advertFields...)
+	if err != nil {
+		return err
+	}
+	advertListResponse, err := controller.queryHandler.GetAdverts.Handle(ctx, &queries.GetAdvertsQuery{Ids: ids, Fields: fields})
+	if err != nil {
+		return err
+	}
+	return jsonWithFields(c, 200, advertListResponse, fields, "adverts")
+}
+
+func (controller *advertController) searchAdverts(c echo.Context) error {
+	ctx := c.Request().Context()
+	advertFilter, err := advertFilterParams(c)
+	if err != nil {
+		return err
+	}
+	facets, err := queryParamList(c, "facets", "category", "creationMonth")
+	if err != nil {
+		return err
+	}
+	sort := c.QueryParam("sort")
+	switch sort {
+	case "", queries.SortByRelevance:
+	case queries.SortByDistance:
+		if advertFilter.Near == nil {
+			return custom_error.BadRequestErr("lat and lon are required to sort by distance")
+		}
+	default:
+		return custom_error.BadRequestErrWithArgs("sort must be %s or %s", queries.SortByRelevance, queries.SortByDistance)
+	}
+	fields, err := queryParamList(c, "fields", advertFields...)
+	if err != nil {
+		return err
+	}
+	page, size, err := pagingParams(c)
+	if err != nil {
+		return err
+	}
+	if err := validation.ResultWindow(page, size); err != nil {
+		return err
+	}
+	searchResponse, err := controller.queryHandler.SearchAdverts.Handle(ctx, &queries.SearchAdvertsQuery{
+		AdvertFilter: *advertFilter,
+		Facets:       facets,
+		Sort:         sort,
+		Page:         page,
+		Size:         size,
+		Fields:       fields,
+	})
+	if err != nil {
+		return err
+	}
+	return jsonWithFields(c, 200, searchResponse, fields, "adverts")
+}
+
+// GetAdvertChanges godoc
+// @tags adverts
+// @Accept  json
+// @Produce  json
+// @Param since query string false "changes modified at or after this date are returned, yyyy-MM-dd or RFC 3339, changes of the last 30 seconds are returned by a later call"
+// @Param checkpoint query string false "checkpoint returned by the previous call, the feed continues after it"
+// @Param size query int false "page size, max 100"
+// @Success  200  {object}  model_api.AdvertChangesResponse
+// @Failure  400  {object} custom_error.CustomError
+// @Router /adverts/changes [get]
+func (controller *advertController) GetAdvertChanges(c echo.Context) error {
+	ctx := c.Request().Context()
+	since, err := queryParamDate(c, "since")
+	if err != nil {
+		return err
+	}
+	size, err := pageSizeParam(c)
+	if err != nil {
+		return err
+	}
+	advertChangesResponse, err := controller.queryHandler.GetAdvertChanges.Handle(ctx, &queries.GetAdvertChangesQuery{
+		Since:      since,
+		Checkpoint: c.QueryParam("checkpoint"),
+		Size:       size,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, advertChangesResponse)
+}
+
+// ExportAdverts godoc
+// @tags adverts
+// @Produce  application/x-ndjson
+// @Param categoryId query int false "category id, all adverts are exported when it is not given"
+// @Success  200  {object}  model_api.AdvertResponse "one advert per line, an export failing after its first line ends with a model_api.AdvertExportErrorResponse line"
+// @Failure  400  {object} custom_error.CustomError
+// @Failure  500  {object} custom_error.CustomError
+// @Router /adverts/_export [get]
+func (controller *advertController) ExportAdverts(c echo.Context) error {
+	ctx := c.Request().Context()
+	categoryId, err := queryParamInt64(c, "categoryId", 0)
+	if err != nil {
+		return err
+	}
+	response := c.Response()
+	// the status is sent with the first batch, so an export failing before it is answered with an error status
+	writeHeader := func() {
+		if !response.Committed {
+			response.Header().Set(echo.HeaderContentType, "application/x-ndjson")
+			response.WriteHeader(http.StatusOK)
+		}
+	}
+	encoder := custom_json.JsonIter.NewEncoder(response)
+	// the request context is cancelled when the client disconnects, which stops the scroll
+	_, err = controller.queryHandler.ExportAdverts.Handle(ctx, &queries.ExportAdvertsQuery{
+		CategoryId: categoryId,
+		Write: func(adverts []*model_api.AdvertResponse) error {
+			writeHeader()
+			for _, advert := range adverts {
+				if err := encoder.Encode(advert); err != nil {
+					return err
+				}
+			}
+			response.Flush()
+			return nil
+		},
+	})
+	if err == nil {
+		writeHeader()
+		return nil
+	}
+	if !response.Committed {
+		return err
+	}
+	if ctx.Err() == nil {
+		log.Errorf("ExportAdverts, export stopped for categoryId: %d, err: %s", categoryId, err.Error())
+		// the 200 status is already sent, the error line tells the client the export is incomplete
+		_ = encoder.Encode(&model_api.AdvertExportErrorResponse{Error: "export stopped before the last advert"})
+		response.Flush()
+	}
+	return nil
+}
+
+// CountAdverts godoc
+// @tags adverts
+// @Accept  json
+// @Produce  json
+// @Param q query string false "text searched in title and description"
+// @Param categoryId query int false "category id"
+// @Param creationDateFrom query string false "inclusive creation date lower bound, yyyy-MM-dd or RFC 3339"
+// @Param creationDateTo query string false "inclusive creation date upper bound, yyyy-MM-dd or RFC 3339"
+// @Param creationDate[gte] query string false "range filter, gte, gt, lte and lt operators are supported on creationDate, lastModifiedDate and version"
+// @Param lat query number false "latitude of the origin, required with lon"
+// @Param lon query number false "longitude of the origin, required with lat"
+// @Param radius query number false "keeps adverts within radius kilometres of the origin"
+// @Param exact query bool false "counts exactly when true (default), otherwise a lower bound may be returned for large counts"
+// @Success  200  {object}  model_api.AdvertCountResponse
+// @Failure  400  {object} custom_error.CustomError
+// @Router /adverts/_count [get]
+func (controller *advertController) CountAdverts(c echo.Context) error {
+	ctx := c.Request().Context()
+	advertFilter, err := advertFilterParams(c)
+	if err != nil {
+		return err
+	}
+	exact := true
+	if exactStr := c.QueryParam("exact"); exactStr != "" {
+		exact, err = strconv.ParseBool(exactStr)
+		if err != nil {
+			return custom_error.BadRequestErr("exact must be true or false")
+		}
+	}
+	countResponse, err := controller.queryHandler.CountAdverts.Handle(ctx, &queries.CountAdvertsQuery{
+		AdvertFilter: *advertFilter,
+		Exact:        exact,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, countResponse)
+}
+
+func advertFilterParams(c echo.Context) (*queries.AdvertFilter, error) {
+	categoryId, err := queryParamInt64(c, "categoryId", 0)
+	if err != nil {
+		return nil, err
+	}
+	creationDateFrom, err := queryParamDate(c, "creationDateFrom")
+	if err != nil {
+		return nil, err
+	}
+	creationDateTo, err := queryParamDate(c, "creationDateTo")
+	if err != nil {
+		return nil, err
+	}
+	ranges, err := rangeParams(c, advertRangeFields)
+	if err != nil {
+		return nil, err
+	}
+	near, err := nearParams(c)
+	if err != nil {
+		return nil, err
+	}
+	return &queries.AdvertFilter{
+		Query:            c.QueryParam("q"),
+		CategoryId:       categoryId,
+		CreationDateFrom: creationDateFrom,
+		CreationDateTo:   creationDateTo,
+		Ranges:           ranges,
+		Near:             near,
+	}, nil
+}
+
+// nearParams reads lat, lon and radius in kilometres, lat and lon are given together and radius needs them
+func nearParams(c echo.Context) (*queries.Near, error) {
+	latStr, lonStr, radiusStr := c.QueryParam("lat"), c.QueryParam("lon"), c.QueryParam("radius")
+	if latStr == "" && lonStr == "" {
+		if radiusStr != "" {
+			return nil, custom_error.BadRequestErr("lat and lon are required with radius")
+		}
+		return nil, nil
+	}
+	lat, err := strconv.ParseFloat(latStr, 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return nil, custom_error.BadRequestErr("lat must be a number between -90 and 90")
+	}
+	lon, err := strconv.ParseFloat(lonStr, 64)
+	if err != nil || lon < -180 || lon > 180 {
+		return nil, custom_error.BadRequestErr("lon must be a number between -180 and 180")
+	}
+	near := &queries.Near{Lat: lat, Lon: lon}
+	if radiusStr != "" {
+		near.Radius, err = strconv.ParseFloat(radiusStr, 64)
+		if err != nil || near.Radius <= 0 {
+			return nil, custom_error.BadRequestErr("radius must be a positive number")
+		}
+	}
+	return near, nil
+}
+
+// AdvertExists godoc
+// @tags adverts
+// @Param id path string true "id"
+// @Success  200  "Advert exists"
+// @Failure  400  "id is not a number"
+// @Failure  404  "Advert does not exist"
+// @Router /adverts/{id} [head]
+func (controller *advertController) AdvertExists(c echo.Context) error {
+	ctx := c.Request().Context()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return custom_error.BadRequestErr("id must be number")
+	}
+	exists, err := controller.queryHandler.AdvertExists.Handle(ctx, &queries.AdvertExistsQuery{Id: id})
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return c.NoContent(http.StatusNotFound)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// GetSimilarAdverts godoc
+// @tags adverts
+// @Accept  json
+// @Produce  json
+// @Param id path string true "id"
+// @Param size query int false "similar advert count, max 20"
+// @Success  200  {object}  model_api.SimilarAdvertsResponse
+// @Failure  400  {object} custom_error.CustomError
+// @Failure  404  {object} custom_error.CustomError
+// @Router /adverts/{id}/similar [get]
+func (controller *advertController) GetSimilarAdverts(c echo.Context) error {
+	ctx := c.Request().Context()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return custom_error.BadRequestErr("id must be number")
+	}
+	size, err := queryParamInt(c, "size", defaultSimilarAdvertSize)
+	if err != nil {
+		return err
+	}
+	if size < 1 || size > maxSimilarAdvertSize {
+		return custom_error.BadRequestErrWithArgs("size must be between 1 and %d", maxSimilarAdvertSize)
+	}
+	similarAdvertsResponse, err := controller.queryHandler.GetSimilarAdverts.Handle(ctx, &queries.GetSimilarAdvertsQuery{
+		Id:   id,
+		Size: size,
+	})
 	if err != nil {
 		return err
 	}
-	return c.JSON(200, advertResponse)
+	return c.JSON(200, similarAdvertsResponse)
 }
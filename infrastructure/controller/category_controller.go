@@ -2,9 +2,11 @@ package controller
 
 import (
 	"github.com/labstack/echo/v4"
+	"net/http"
 	"presentation-advert-read-api/application/handlers"
 	"presentation-advert-read-api/application/queries"
 	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"presentation-advert-read-api/infrastructure/validation"
 	"strconv"
 )
 
@@ -23,7 +25,12 @@ func NewCategoryController(
 }
 
 func (controller *categoryController) register(e *echo.Echo) {
+	e.GET("/categories", controller.GetCategories)
 	e.GET("/categories/:id", controller.GetCategoryById)
+	e.HEAD("/categories/:id", controller.CategoryExists)
+	e.GET("/categories/:id/adverts", controller.GetCategoryAdverts)
+	e.GET("/categories/:id/tree", controller.GetCategoryTree)
+	e.GET("/categories/:id/ancestors", controller.GetCategoryAncestors)
 
 }
 
@@ -32,7 +39,15 @@ func (controller *categoryController) register(e *echo.Echo) {
 // @Accept  json
 // @Produce  json
 // @Param id path string true "id"
+// @Param fields query string false "comma separated fields to return, id is always returned"
+// @Param lang query string false "language tag of the category names like en or en-US, takes precedence over Accept-Language"
+// @Param Accept-Language header string false "preferred languages of the category names"
+// @Param view query string false "default or full, full adds version and audit fields"
+// @Param Prefer header string false "view=full is the same as ?view=full"
+// @Param If-None-Match header string false "etag of the cached response"
+// @Param If-Modified-Since header string false "last modified date of the cached response"
 // @Success  200  {object}  model_api.CategoryResponse
+// @Success  304  "Not Modified"
 // @Failure  400  {object} custom_error.CustomError
 // @Failure  404  {object} custom_error.CustomError
 // @Router /categories/{id} [get]
@@ -43,9 +58,225 @@ func (controller *categoryController) GetCategoryById(c echo.Context) error {
 	if err != nil {
 		return custom_error.BadRequestErr("id must be number")
 	}
-	categoryResponse, err := controller.queryHandler.GetCategory.Handle(ctx, &queries.GetCategoryQuery{Id: id})
+	view, err := viewParam(c)
 	if err != nil {
 		return err
 	}
-	return c.JSON(200, categoryResponse)
+	fields, err := queryParamList(c, "fields", viewFields(view, categoryFields)...)
+	if err != nil {
+		return err
+	}
+	query := &queries.GetCategoryQuery{Id: id, Fields: fields}
+	if view == fullView {
+		categoryFullResponse, err := controller.queryHandler.GetCategoryFull.Handle(ctx, query)
+		if err != nil {
+			return err
+		}
+		if checkNotModified(c, categoryFullResponse.Id, categoryFullResponse.Version, categoryFullResponse.LastModifiedDate, view, fields) {
+			return c.NoContent(http.StatusNotModified)
+		}
+		return jsonWithFields(c, 200, categoryFullResponse, fields, "")
+	}
+	categoryResponse, err := controller.queryHandler.GetCategory.Handle(ctx, query)
+	if err != nil {
+		return err
+	}
+	if checkNotModified(c, categoryResponse.Id, categoryResponse.Version, categoryResponse.LastModifiedDate, view, fields) {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return jsonWithFields(c, 200, categoryResponse, fields, "")
+}
+
+// GetCategories godoc
+// @tags categories
+// @Accept  json
+// @Produce  json
+// @Param ids query string false "comma separated category ids, when given categories are fetched by id and model_api.CategoryListResponse is returned"
+// @Param prefix query string false "name prefix, matched against the default name whatever the language"
+// @Param depth[lte] query int false "range filter, gte, gt, lte and lt operators are supported on creationDate, lastModifiedDate, version and depth"
+// @Param sort query string false "sort field, id or name, name sorts by the default name whatever the language"
+// @Param order query string false "sort order, asc or desc"
+// @Param fields query string false "comma separated fields to return, id is always returned"
+// @Param lang query string false "language tag of the category names like en or en-US, takes precedence over Accept-Language"
+// @Param Accept-Language header string false "preferred languages of the category names"
+// @Param page query int false "page number, starts from 0, (page+1)*size must be at most 10000"
+// @Param size query int false "page size, max 100"
+// @Success  200  {object}  model_api.CategorySearchResponse
+// @Failure  400  {object} custom_error.CustomError
+// @Router /categories [get]
+func (controller *categoryController) GetCategories(c echo.Context) error {
+	if c.QueryParams().Has("ids") {
+		return controller.getCategoriesByIds(c)
+	}
+	return controller.listCategories(c)
+}
+
+func (controller *categoryController) getCategoriesByIds(c echo.Context) error {
+	ctx := c.Request().Context()
+	ids, err := queryParamIds(c, "ids")
+	if err != nil {
+		return err
+	}
+	fields, err := queryParamList(c, "fields", categoryFields...)
+	if err != nil {
+		return err
+	}
+	categoryListResponse, err := controller.queryHandler.GetCategories.Handle(ctx, &queries.GetCategoriesQuery{Ids: ids, Fields: fields})
+	if err != nil {
+		return err
+	}
+	return jsonWithFields(c, 200, categoryListResponse, fields, "categories")
+}
+
+func (controller *categoryController) listCategories(c echo.Context) error {
+	ctx := c.Request().Context()
+	sort := c.QueryParam("sort")
+	if sort == "" {
+		sort = "id"
+	}
+	if sort != "id" && sort != "name" {
+		return custom_error.BadRequestErr("sort must be id or name")
+	}
+	descending, err := sortOrderParam(c)
+	if err != nil {
+		return err
+	}
+	fields, err := queryParamList(c, "fields", categoryFields...)
+	if err != nil {
+		return err
+	}
+	ranges, err := rangeParams(c, categoryRangeFields)
+	if err != nil {
+		return err
+	}
+	page, size, err := pagingParams(c)
+	if err != nil {
+		return err
+	}
+	if err := validation.ResultWindow(page, size); err != nil {
+		return err
+	}
+	categorySearchResponse, err := controller.queryHandler.ListCategories.Handle(ctx, &queries.ListCategoriesQuery{
+		NamePrefix: c.QueryParam("prefix"),
+		Ranges:     ranges,
+		Sort:       sort,
+		Descending: descending,
+		Page:       page,
+		Size:       size,
+		Fields:     fields,
+	})
+	if err != nil {
+		return err
+	}
+	return jsonWithFields(c, 200, categorySearchResponse, fields, "categories")
+}
+
+// GetCategoryAdverts godoc
+// @tags categories
+// @Accept  json
+// @Produce  json
+// @Param id path string true "id"
+// @Param cursor query string false "next cursor returned by the previous page"
+// @Param size query int false "page size, max 100"
+// @Param fields query string false "comma separated fields to return, id is always returned"
+// @Param lang query string false "language tag of the category names like en or en-US, takes precedence over Accept-Language"
+// @Param Accept-Language header string false "preferred languages of the category names"
+// @Success  200  {object}  model_api.CategoryAdvertsResponse
+// @Failure  400  {object} custom_error.CustomError
+// @Router /categories/{id}/adverts [get]
+func (controller *categoryController) GetCategoryAdverts(c echo.Context) error {
+	ctx := c.Request().Context()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return custom_error.BadRequestErr("id must be number")
+	}
+	size, err := pageSizeParam(c)
+	if err != nil {
+		return err
+	}
+	fields, err := queryParamList(c, "fields", advertFields...)
+	if err != nil {
+		return err
+	}
+	categoryAdvertsResponse, err := controller.queryHandler.GetCategoryAdverts.Handle(ctx, &queries.GetCategoryAdvertsQuery{
+		CategoryId: id,
+		Cursor:     c.QueryParam("cursor"),
+		Size:       size,
+		Fields:     fields,
+	})
+	if err != nil {
+		return err
+	}
+	return jsonWithFields(c, 200, categoryAdvertsResponse, fields, "adverts")
+}
+
+// GetCategoryTree godoc
+// @tags categories
+// @Accept  json
+// @Produce  json
+// @Param id path string true "id"
+// @Success  200  {object}  model_api.CategoryTreeResponse
+// @Failure  400  {object} custom_error.CustomError
+// @Failure  404  {object} custom_error.CustomError
+// @Router /categories/{id}/tree [get]
+func (controller *categoryController) GetCategoryTree(c echo.Context) error {
+	ctx := c.Request().Context()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return custom_error.BadRequestErr("id must be number")
+	}
+	categoryTreeResponse, err := controller.queryHandler.GetCategoryTree.Handle(ctx, &queries.GetCategoryTreeQuery{Id: id})
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, categoryTreeResponse)
+}
+
+// GetCategoryAncestors godoc
+// @tags categories
+// @Accept  json
+// @Produce  json
+// @Param id path string true "id"
+// @Success  200  {object}  model_api.CategoryAncestorsResponse
+// @Failure  400  {object} custom_error.CustomError
+// @Failure  404  {object} custom_error.CustomError
+// @Router /categories/{id}/ancestors [get]
+func (controller *categoryController) GetCategoryAncestors(c echo.Context) error {
+	ctx := c.Request().Context()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return custom_error.BadRequestErr("id must be number")
+	}
+	categoryAncestorsResponse, err := controller.queryHandler.GetCategoryAncestors.Handle(ctx, &queries.GetCategoryAncestorsQuery{Id: id})
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, categoryAncestorsResponse)
+}
+
+// CategoryExists godoc
+// @tags categories
+// @Param id path string true "id"
+// @Success  200  "Category exists"
+// @Failure  400  "id is not a number"
+// @Failure  404  "Category does not exist"
+// @Router /categories/{id} [head]
+func (controller *categoryController) CategoryExists(c echo.Context) error {
+	ctx := c.Request().Context()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return custom_error.BadRequestErr("id must be number")
+	}
+	exists, err := controller.queryHandler.CategoryExists.Handle(ctx, &queries.CategoryExistsQuery{Id: id})
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return c.NoContent(http.StatusNotFound)
+	}
+	return c.NoContent(http.StatusOK)
 }
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
+	"slices"
+	"strings"
+	"time"
+)
+
+var lastModifiedDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05",
+}
+
+// checkNotModified sets ETag and Last-Modified headers from the document version and
+// reports whether the request preconditions allow answering with 304 Not Modified.
+// Each view and field selection is a different representation, so a non default view and
+// the sorted requested fields are part of the ETag.
+func checkNotModified(c echo.Context, id int64, version int16, lastModifiedDate string, view string, fields []string) bool {
+	tag := fmt.Sprintf("%d-%d", id, version)
+	if view != defaultView {
+		tag += "-" + view
+	}
+	if len(fields) > 0 {
+		// a comma would split the tag in If-None-Match
+		tag += "-" + strings.Join(slices.Sorted(slices.Values(fields)), "+")
+	}
+	// names resolve through the whole language chain, the same version is a different representation for another chain
+	if languages := locale.ContextKey(c.Request().Context()); languages != "" {
+		tag += "-" + strings.ReplaceAll(languages, ",", "+")
+	}
+	etag := `W/"` + tag + `"`
+	header := c.Response().Header()
+	header.Set("ETag", etag)
+	lastModified, hasLastModified := parseLastModifiedDate(lastModifiedDate)
+	if hasLastModified {
+		header.Set(echo.HeaderLastModified, lastModified.Format(http.TimeFormat))
+	}
+
+	request := c.Request()
+	// If-Modified-Since is ignored when If-None-Match is present, RFC 7232 section 3.3
+	if ifNoneMatch := request.Header.Get("If-None-Match"); ifNoneMatch != "" {
+		return etagMatches(ifNoneMatch, etag)
+	}
+	if ifModifiedSince := request.Header.Get(echo.HeaderIfModifiedSince); ifModifiedSince != "" && hasLastModified {
+		since, err := http.ParseTime(ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		return !lastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+func parseLastModifiedDate(lastModifiedDate string) (time.Time, bool) {
+	if lastModifiedDate == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range lastModifiedDateLayouts {
+		if parsed, err := time.Parse(layout, lastModifiedDate); err == nil {
+			return parsed.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
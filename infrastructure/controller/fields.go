@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"bytes"
+	"github.com/labstack/echo/v4"
+	"presentation-advert-read-api/infrastructure/configuration/custom_json"
+	"strings"
+)
+
+// id is always part of the response, so it is not listed as a selectable field
+var (
+	advertFields   = []string{"title", "description", "category", "category.id", "category.name", "location", "city", "district"}
+	categoryFields = []string{"name"}
+)
+
+// jsonWithFields writes the response keeping only the requested fields of its items.
+// Items are read from itemsKey of the response or the response itself when itemsKey is empty.
+func jsonWithFields(c echo.Context, code int, response interface{}, fields []string, itemsKey string) error {
+	if len(fields) == 0 {
+		return c.JSON(code, response)
+	}
+	responseBytes, err := custom_json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	var document map[string]interface{}
+	decoder := custom_json.JsonIter.NewDecoder(bytes.NewReader(responseBytes))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return err
+	}
+	if itemsKey == "" {
+		return c.JSON(code, selectFields(document, fields))
+	}
+	if items, ok := document[itemsKey].([]interface{}); ok {
+		for i, item := range items {
+			if itemDocument, ok := item.(map[string]interface{}); ok {
+				items[i] = selectFields(itemDocument, fields)
+			}
+		}
+	}
+	return c.JSON(code, document)
+}
+
+func selectFields(document map[string]interface{}, fields []string) map[string]interface{} {
+	selected := map[string]interface{}{"id": document["id"]}
+	// distance is calculated by the search, it is not a stored field
+	if distance, exists := document["distance"]; exists {
+		selected["distance"] = distance
+	}
+	for _, field := range fields {
+		copyField(document, selected, strings.Split(field, "."))
+	}
+	return selected
+}
+
+func copyField(source map[string]interface{}, target map[string]interface{}, path []string) {
+	value, exists := source[path[0]]
+	if !exists {
+		return
+	}
+	if len(path) == 1 {
+		target[path[0]] = value
+		return
+	}
+	nestedSource, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	nestedTarget, ok := target[path[0]].(map[string]interface{})
+	if !ok {
+		nestedTarget = make(map[string]interface{})
+		target[path[0]] = nestedTarget
+	}
+	copyField(nestedSource, nestedTarget, path[1:])
+}
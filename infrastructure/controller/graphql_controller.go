@@ -0,0 +1,47 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"presentation-advert-read-api/infrastructure/graphql_api"
+	"strings"
+)
+
+type graphqlController struct {
+	executor *graphql_api.Executor
+}
+
+func NewGraphqlController(
+	echo *echo.Echo,
+	executor *graphql_api.Executor,
+) {
+	controller := &graphqlController{
+		executor: executor,
+	}
+	controller.register(echo)
+}
+
+func (controller *graphqlController) register(e *echo.Echo) {
+	e.POST("/graphql", controller.Graphql)
+
+}
+
+// Graphql godoc
+// @tags graphql
+// @Accept  json
+// @Produce  json
+// @Param request body graphql_api.Request true "graphql query, operation name and variables"
+// @Success  200  {object}  object "data and errors of the query, limit and validation errors are returned in errors"
+// @Failure  400  {object} custom_error.CustomError
+// @Router /graphql [post]
+func (controller *graphqlController) Graphql(c echo.Context) error {
+	ctx := c.Request().Context()
+	request := new(graphql_api.Request)
+	if err := c.Bind(request); err != nil {
+		return custom_error.BadRequestErr("request body must be a graphql request")
+	}
+	if strings.TrimSpace(request.Query) == "" {
+		return custom_error.BadRequestErr("query must not be empty")
+	}
+	return c.JSON(200, controller.executor.Execute(ctx, request))
+}
@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"presentation-advert-read-api/infrastructure/validation"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const maxIdCount = 100
+
+func queryParamInt64(c echo.Context, name string, defaultValue int64) (int64, error) {
+	valueStr := c.QueryParam(name)
+	if valueStr == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.ParseInt(valueStr, 10, 64)
+	if err != nil {
+		return 0, custom_error.BadRequestErrWithArgs("%s must be number", name)
+	}
+	return value, nil
+}
+
+func queryParamInt(c echo.Context, name string, defaultValue int) (int, error) {
+	value, err := queryParamInt64(c, name, int64(defaultValue))
+	if err != nil {
+		return 0, err
+	}
+	return int(value), nil
+}
+
+func pagingParams(c echo.Context) (int, int, error) {
+	page, err := queryParamInt(c, "page", 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := validation.Page(page); err != nil {
+		return 0, 0, err
+	}
+	size, err := pageSizeParam(c)
+	if err != nil {
+		return 0, 0, err
+	}
+	return page, size, nil
+}
+
+func pageSizeParam(c echo.Context) (int, error) {
+	size, err := queryParamInt(c, "size", validation.DefaultPageSize)
+	if err != nil {
+		return 0, err
+	}
+	if err := validation.PageSize(size); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// queryParamDate accepts a yyyy-MM-dd date or an RFC 3339 timestamp and returns it unchanged
+func queryParamDate(c echo.Context, name string) (string, error) {
+	value := c.QueryParam(name)
+	if err := validation.Date(name, value); err != nil {
+		return "", err
+	}
+	return value, nil
+}
+
+func sortOrderParam(c echo.Context) (bool, error) {
+	switch c.QueryParam("order") {
+	case "", "asc":
+		return false, nil
+	case "desc":
+		return true, nil
+	}
+	return false, custom_error.BadRequestErr("order must be asc or desc")
+}
+
+// queryParamList splits a comma separated parameter and checks every value against allowedValues
+func queryParamList(c echo.Context, name string, allowedValues ...string) ([]string, error) {
+	values := make([]string, 0)
+	for _, value := range strings.Split(c.QueryParam(name), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if !slices.Contains(allowedValues, value) {
+			return nil, custom_error.BadRequestErrWithArgs("%s must be one of %s", name, strings.Join(allowedValues, ","))
+		}
+		if !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
+func queryParamIds(c echo.Context, name string) ([]int64, error) {
+	ids := make([]int64, 0)
+	for _, idStr := range strings.Split(c.QueryParam(name), ",") {
+		idStr = strings.TrimSpace(idStr)
+		if idStr == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			return nil, custom_error.BadRequestErrWithArgs("%s must be comma separated numbers", name)
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, custom_error.BadRequestErrWithArgs("%s must not be empty", name)
+	}
+	if len(ids) > maxIdCount {
+		return nil, custom_error.BadRequestErrWithArgs("%s must contain at most %d ids", name, maxIdCount)
+	}
+	return ids, nil
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"presentation-advert-read-api/infrastructure/validation"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+type rangeFieldType int
+
+const (
+	dateRangeField rangeFieldType = iota
+	numberRangeField
+)
+
+// rangeable fields of the search endpoints by their value type
+var (
+	advertRangeFields = map[string]rangeFieldType{
+		"creationDate":     dateRangeField,
+		"lastModifiedDate": dateRangeField,
+		"version":          numberRangeField,
+	}
+	categoryRangeFields = map[string]rangeFieldType{
+		"creationDate":     dateRangeField,
+		"lastModifiedDate": dateRangeField,
+		"version":          numberRangeField,
+		"depth":            numberRangeField,
+	}
+)
+
+var rangeParamPattern = regexp.MustCompile(`^(\w+)\[(\w+)\]$`)
+
+// rangeParams reads field[operator]=value parameters, fields must be in allowedFields and values must match the field type
+func rangeParams(c echo.Context, allowedFields map[string]rangeFieldType) ([]queries.RangeFilter, error) {
+	names := make([]string, 0)
+	for name := range c.QueryParams() {
+		if rangeParamPattern.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+	// query parameters are a map, sorting keeps the generated query stable
+	sort.Strings(names)
+	ranges := make([]queries.RangeFilter, 0, len(names))
+	for _, name := range names {
+		match := rangeParamPattern.FindStringSubmatch(name)
+		field, operator, value := match[1], match[2], c.QueryParam(name)
+		fieldType, allowed := allowedFields[field]
+		if !allowed {
+			return nil, custom_error.BadRequestErrWithArgs("%s is not a range field", field)
+		}
+		switch operator {
+		case "gte", "gt", "lte", "lt":
+		default:
+			return nil, custom_error.BadRequestErrWithArgs("%s range operator must be gte, gt, lte or lt", name)
+		}
+		switch fieldType {
+		case dateRangeField:
+			if !validation.IsDate(value) {
+				return nil, custom_error.BadRequestErrWithArgs("%s must be a yyyy-MM-dd date or an RFC 3339 timestamp", name)
+			}
+		case numberRangeField:
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return nil, custom_error.BadRequestErrWithArgs("%s must be number", name)
+			}
+		}
+		ranges = append(ranges, queries.RangeFilter{Field: field, Operator: operator, Value: value})
+	}
+	return ranges, nil
+}
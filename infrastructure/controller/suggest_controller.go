@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"strings"
+)
+
+const (
+	defaultSuggestionSize = 10
+	maxSuggestionSize     = 20
+)
+
+type suggestController struct {
+	queryHandler *handlers.QueryHandler
+}
+
+func NewSuggestController(
+	echo *echo.Echo,
+	queryHandler *handlers.QueryHandler,
+) {
+	controller := &suggestController{
+		queryHandler: queryHandler,
+	}
+	controller.register(echo)
+}
+
+func (controller *suggestController) register(e *echo.Echo) {
+	e.GET("/suggest", controller.Suggest)
+
+}
+
+// Suggest godoc
+// @tags suggest
+// @Accept  json
+// @Produce  json
+// @Param prefix query string true "prefix typed by the user, category names are matched and returned in their default language"
+// @Param size query int false "suggestion count, max 20"
+// @Success  200  {object}  model_api.SuggestResponse
+// @Failure  400  {object} custom_error.CustomError
+// @Router /suggest [get]
+func (controller *suggestController) Suggest(c echo.Context) error {
+	ctx := c.Request().Context()
+	prefix := strings.TrimSpace(c.QueryParam("prefix"))
+	if prefix == "" {
+		return custom_error.BadRequestErr("prefix must not be empty")
+	}
+	size, err := queryParamInt(c, "size", defaultSuggestionSize)
+	if err != nil {
+		return err
+	}
+	if size < 1 || size > maxSuggestionSize {
+		return custom_error.BadRequestErrWithArgs("size must be between 1 and %d", maxSuggestionSize)
+	}
+	suggestResponse, err := controller.queryHandler.Suggest.Handle(ctx, &queries.SuggestQuery{
+		Prefix: prefix,
+		Size:   size,
+	})
+	if err != nil {
+		return err
+	}
+	return c.JSON(200, suggestResponse)
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"github.com/labstack/echo/v4"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"strings"
+)
+
+const (
+	defaultView = "default"
+	fullView    = "full"
+)
+
+// auditFields can be selected with ?fields= only in the full view
+var auditFields = []string{"version", "createdBy", "creationDate", "modifiedBy", "lastModifiedDate"}
+
+// viewParam reads the response view from ?view= or from a "Prefer: view=full" header, the query parameter wins
+func viewParam(c echo.Context) (string, error) {
+	header := c.Response().Header()
+	header.Add(echo.HeaderVary, "Prefer")
+	switch view := c.QueryParam("view"); view {
+	case "":
+	case defaultView, fullView:
+		return view, nil
+	default:
+		return "", custom_error.BadRequestErrWithArgs("view must be %s or %s", defaultView, fullView)
+	}
+	for _, preference := range strings.Split(c.Request().Header.Get("Prefer"), ",") {
+		if strings.TrimSpace(preference) == "view="+fullView {
+			header.Set("Preference-Applied", "view="+fullView)
+			return fullView, nil
+		}
+	}
+	return defaultView, nil
+}
+
+// viewFields returns the fields that can be selected in the view
+func viewFields(view string, fields []string) []string {
+	if view != fullView {
+		return fields
+	}
+	return append(append(make([]string, 0, len(fields)+len(auditFields)), fields...), auditFields...)
+}
@@ -0,0 +1,122 @@
+package graphql_api
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/model/model_api"
+	"slices"
+	"sync"
+)
+
+// maxBatchSize is the id limit of the multi-get endpoints
+const maxBatchSize = 100
+
+type loadersKey struct{}
+
+// dataLoader collects the ids requested by sibling resolvers and fetches all of them with multi-gets
+// when the first result is needed. The executor resolves thunks breadth first, so each level of a query
+// costs one batch instead of one request per object.
+type dataLoader[T any] struct {
+	mutex   sync.Mutex
+	fetch   func(ctx context.Context, ids []int64) (map[int64]*T, error)
+	pending []int64
+	results map[int64]*T
+	errors  map[int64]error
+}
+
+func newDataLoader[T any](fetch func(ctx context.Context, ids []int64) (map[int64]*T, error)) *dataLoader[T] {
+	return &dataLoader[T]{
+		fetch:   fetch,
+		results: make(map[int64]*T),
+		errors:  make(map[int64]error),
+	}
+}
+
+// Load schedules the id for the next batch and returns a thunk that waits for it, missing documents are nil
+func (loader *dataLoader[T]) Load(ctx context.Context, id int64) func() (interface{}, error) {
+	loader.mutex.Lock()
+	if _, loaded := loader.results[id]; !loaded && !slices.Contains(loader.pending, id) {
+		loader.pending = append(loader.pending, id)
+	}
+	loader.mutex.Unlock()
+	return func() (interface{}, error) {
+		result, err := loader.get(ctx, id)
+		if err != nil || result == nil {
+			return nil, err
+		}
+		return result, nil
+	}
+}
+
+// Prime stores a document that was fetched by another resolver
+func (loader *dataLoader[T]) Prime(id int64, value *T) {
+	loader.mutex.Lock()
+	defer loader.mutex.Unlock()
+	loader.results[id] = value
+}
+
+func (loader *dataLoader[T]) get(ctx context.Context, id int64) (*T, error) {
+	loader.mutex.Lock()
+	defer loader.mutex.Unlock()
+	if result, loaded := loader.results[id]; loaded {
+		return result, nil
+	}
+	if err, failed := loader.errors[id]; failed {
+		return nil, err
+	}
+	ids := loader.pending
+	loader.pending = nil
+	for start := 0; start < len(ids); start += maxBatchSize {
+		batch := ids[start:min(start+maxBatchSize, len(ids))]
+		results, err := loader.fetch(ctx, batch)
+		for _, batchId := range batch {
+			if err != nil {
+				loader.errors[batchId] = err
+				continue
+			}
+			loader.results[batchId] = results[batchId]
+		}
+	}
+	return loader.results[id], loader.errors[id]
+}
+
+type loaders struct {
+	adverts    *dataLoader[model_api.AdvertResponse]
+	categories *dataLoader[model_api.CategoryResponse]
+}
+
+func newLoaders(queryHandler *handlers.QueryHandler) *loaders {
+	return &loaders{
+		adverts: newDataLoader(func(ctx context.Context, ids []int64) (map[int64]*model_api.AdvertResponse, error) {
+			advertListResponse, err := queryHandler.GetAdverts.Handle(ctx, &queries.GetAdvertsQuery{Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			adverts := make(map[int64]*model_api.AdvertResponse, len(advertListResponse.Adverts))
+			for i := range advertListResponse.Adverts {
+				adverts[advertListResponse.Adverts[i].Id] = &advertListResponse.Adverts[i]
+			}
+			return adverts, nil
+		}),
+		categories: newDataLoader(func(ctx context.Context, ids []int64) (map[int64]*model_api.CategoryResponse, error) {
+			categoryListResponse, err := queryHandler.GetCategories.Handle(ctx, &queries.GetCategoriesQuery{Ids: ids})
+			if err != nil {
+				return nil, err
+			}
+			categories := make(map[int64]*model_api.CategoryResponse, len(categoryListResponse.Categories))
+			for i := range categoryListResponse.Categories {
+				categories[categoryListResponse.Categories[i].Id] = &categoryListResponse.Categories[i]
+			}
+			return categories, nil
+		}),
+	}
+}
+
+func withLoaders(ctx context.Context, requestLoaders *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, requestLoaders)
+}
+
+func loadersOf(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,57 @@
+package graphql_api
+
+import (
+	"context"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"presentation-advert-read-api/application/handlers"
+)
+
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type Executor struct {
+	schema       graphql.Schema
+	queryHandler *handlers.QueryHandler
+}
+
+func NewExecutor(queryHandler *handlers.QueryHandler) (*Executor, error) {
+	schema, err := newSchema(queryHandler)
+	if err != nil {
+		return nil, err
+	}
+	return &Executor{
+		schema:       schema,
+		queryHandler: queryHandler,
+	}, nil
+}
+
+// Execute validates the request and checks its depth and complexity before any resolver runs,
+// each request gets its own loaders so batching never mixes requests
+func (executor *Executor) Execute(ctx context.Context, request *Request) *graphql.Result {
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(request.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	validationResult := graphql.ValidateDocument(&executor.schema, document, nil)
+	if !validationResult.IsValid {
+		return &graphql.Result{Errors: validationResult.Errors}
+	}
+	if err := checkQueryLimits(document, request.OperationName, request.Variables); err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        executor.schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       withLoaders(ctx, newLoaders(executor.queryHandler)),
+	})
+}
@@ -0,0 +1,123 @@
+package graphql_api
+
+import (
+	"fmt"
+	"github.com/graphql-go/graphql/language/ast"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxQueryDepth      = 6
+	maxQueryComplexity = 1000
+)
+
+// queryCost measures an operation before it is executed. Every field costs 1 and the selections of a list
+// field are multiplied by the number of items it may return, which is its size or the length of its ids.
+type queryCost struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	// err is the first invalid list size, the cost of such an operation is meaningless
+	err error
+}
+
+// checkQueryLimits rejects operations nested deeper than maxQueryDepth or more complex than maxQueryComplexity.
+// The document must be validated before, validation rejects fragment cycles.
+func checkQueryLimits(document *ast.Document, operationName string, variables map[string]interface{}) error {
+	cost := &queryCost{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+	}
+	var operation *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch definition := definition.(type) {
+		case *ast.FragmentDefinition:
+			cost.fragments[definition.Name.Value] = definition
+		case *ast.OperationDefinition:
+			if operationName == "" || (definition.Name != nil && definition.Name.Value == operationName) {
+				operation = definition
+			}
+		}
+	}
+	if operation == nil {
+		return nil
+	}
+	depth, complexity := cost.selectionSet(operation.SelectionSet, 0)
+	if cost.err != nil {
+		return cost.err
+	}
+	if depth > maxQueryDepth {
+		return fmt.Errorf("query depth %d exceeds the limit %d", depth, maxQueryDepth)
+	}
+	if complexity > maxQueryComplexity {
+		return fmt.Errorf("query complexity %d exceeds the limit %d", complexity, maxQueryComplexity)
+	}
+	return nil
+}
+
+func (cost *queryCost) selectionSet(selectionSet *ast.SelectionSet, depth int) (int, int) {
+	if selectionSet == nil {
+		return depth, 0
+	}
+	maxDepth, complexity := depth, 0
+	for _, selection := range selectionSet.Selections {
+		var selectionDepth, selectionComplexity int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			// introspection is answered from the schema, it does not reach the repositories
+			if strings.HasPrefix(selection.Name.Value, "__") {
+				continue
+			}
+			childDepth, childComplexity := cost.selectionSet(selection.SelectionSet, depth+1)
+			selectionDepth, selectionComplexity = max(childDepth, depth+1), 1+cost.listSize(selection)*childComplexity
+		case *ast.InlineFragment:
+			selectionDepth, selectionComplexity = cost.selectionSet(selection.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			if fragment, exists := cost.fragments[selection.Name.Value]; exists {
+				selectionDepth, selectionComplexity = cost.selectionSet(fragment.SelectionSet, depth)
+			}
+		}
+		maxDepth = max(maxDepth, selectionDepth)
+		complexity += selectionComplexity
+	}
+	return maxDepth, complexity
+}
+
+// listSize is the item count a field may return, fields that are not lists return 1.
+// Sizes are clamped to maxListSize and id counts to maxBatchSize, the resolvers reject larger ones anyway.
+func (cost *queryCost) listSize(field *ast.Field) int {
+	for _, argument := range field.Arguments {
+		switch argument.Name.Value {
+		case idsArgument:
+			switch value := argument.Value.(type) {
+			case *ast.ListValue:
+				return min(len(value.Values), maxBatchSize)
+			case *ast.Variable:
+				if ids, ok := cost.variables[value.Name.Value].([]interface{}); ok {
+					return min(len(ids), maxBatchSize)
+				}
+			}
+			return maxBatchSize
+		case sizeArgument:
+			size := maxListSize
+			switch value := argument.Value.(type) {
+			case *ast.IntValue:
+				if parsed, err := strconv.Atoi(value.Value); err == nil {
+					size = parsed
+				}
+			case *ast.Variable:
+				if parsed, ok := cost.variables[value.Name.Value].(float64); ok {
+					size = int(min(parsed, maxListSize))
+				}
+			}
+			if size < 1 && cost.err == nil {
+				cost.err = fmt.Errorf("size of %s must be at least 1", field.Name.Value)
+			}
+			return min(max(size, 1), maxListSize)
+		}
+	}
+	if sizedFields[field.Name.Value] {
+		return defaultListSize
+	}
+	return 1
+}
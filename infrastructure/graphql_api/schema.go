@@ -0,0 +1,294 @@
+package graphql_api
+
+import (
+	"github.com/graphql-go/graphql"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"presentation-advert-read-api/infrastructure/validation"
+	"presentation-advert-read-api/model/model_api"
+	"strconv"
+)
+
+const (
+	idArgument      = "id"
+	idsArgument     = "ids"
+	sizeArgument    = "size"
+	defaultListSize = 10
+	maxListSize     = 20
+)
+
+// sizedFields are the list fields whose size argument is optional
+var sizedFields = map[string]bool{"adverts": true, "siblings": true, "similar": true, "searchAdverts": true}
+
+// resolvers call the query handlers, adverts and categories fetched by id go through the request loaders
+type resolvers struct {
+	queryHandler *handlers.QueryHandler
+}
+
+func newSchema(queryHandler *handlers.QueryHandler) (graphql.Schema, error) {
+	resolver := &resolvers{queryHandler: queryHandler}
+	sizeArgumentConfig := &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultListSize}
+
+	geoPointType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "GeoPoint",
+		Fields: graphql.Fields{
+			"lat": &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+			"lon": &graphql.Field{Type: graphql.NewNonNull(graphql.Float)},
+		},
+	})
+	categoryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Category",
+		Fields: graphql.Fields{
+			"id":   &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"name": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+	advertType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Advert",
+		Fields: graphql.Fields{
+			"id":          &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"title":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"description": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"city":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"district":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"location":    &graphql.Field{Type: geoPointType},
+			"category": &graphql.Field{
+				Type:    categoryType,
+				Resolve: resolver.advertCategory,
+			},
+		},
+	})
+	advertListType := graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(advertType)))
+	advertType.AddFieldConfig("siblings", &graphql.Field{
+		Type:        advertListType,
+		Description: "other adverts of the same category",
+		Args:        graphql.FieldConfigArgument{sizeArgument: sizeArgumentConfig},
+		Resolve:     resolver.advertSiblings,
+	})
+	advertType.AddFieldConfig("similar", &graphql.Field{
+		Type:        advertListType,
+		Description: "adverts with a similar title and description",
+		Args:        graphql.FieldConfigArgument{sizeArgument: sizeArgumentConfig},
+		Resolve:     resolver.similarAdverts,
+	})
+	categoryType.AddFieldConfig("adverts", &graphql.Field{
+		Type:    advertListType,
+		Args:    graphql.FieldConfigArgument{sizeArgument: sizeArgumentConfig},
+		Resolve: resolver.categoryAdverts,
+	})
+	categoryType.AddFieldConfig("ancestors", &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(categoryType))),
+		Description: "the path from the root down to and including this category",
+		Resolve:     resolver.categoryAncestors,
+	})
+
+	idArgumentConfig := graphql.FieldConfigArgument{idArgument: &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)}}
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"advert": &graphql.Field{
+				Type:    advertType,
+				Args:    idArgumentConfig,
+				Resolve: resolver.advert,
+			},
+			"adverts": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(advertType)),
+				Args: graphql.FieldConfigArgument{
+					idsArgument: &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID)))},
+				},
+				Resolve: resolver.adverts,
+			},
+			"category": &graphql.Field{
+				Type:    categoryType,
+				Args:    idArgumentConfig,
+				Resolve: resolver.category,
+			},
+			"searchAdverts": &graphql.Field{
+				Type: advertListType,
+				Args: graphql.FieldConfigArgument{
+					"q":          &graphql.ArgumentConfig{Type: graphql.String},
+					"categoryId": &graphql.ArgumentConfig{Type: graphql.ID},
+					"page":       &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+					sizeArgument: sizeArgumentConfig,
+				},
+				Resolve: resolver.searchAdverts,
+			},
+		},
+	})
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+func (resolver *resolvers) advert(p graphql.ResolveParams) (interface{}, error) {
+	id, err := idArg(p.Args[idArgument])
+	if err != nil {
+		return nil, err
+	}
+	return loadersOf(p.Context).adverts.Load(p.Context, id), nil
+}
+
+func (resolver *resolvers) adverts(p graphql.ResolveParams) (interface{}, error) {
+	idArgs, _ := p.Args[idsArgument].([]interface{})
+	if len(idArgs) > maxBatchSize {
+		return nil, custom_error.BadRequestErrWithArgs("ids must contain at most %d ids", maxBatchSize)
+	}
+	thunks := make([]func() (interface{}, error), 0, len(idArgs))
+	for _, value := range idArgs {
+		id, err := idArg(value)
+		if err != nil {
+			return nil, err
+		}
+		thunks = append(thunks, loadersOf(p.Context).adverts.Load(p.Context, id))
+	}
+	return func() (interface{}, error) {
+		adverts := make([]interface{}, 0, len(thunks))
+		for _, thunk := range thunks {
+			advert, err := thunk()
+			if err != nil {
+				return nil, err
+			}
+			adverts = append(adverts, advert)
+		}
+		return adverts, nil
+	}, nil
+}
+
+func (resolver *resolvers) category(p graphql.ResolveParams) (interface{}, error) {
+	id, err := idArg(p.Args[idArgument])
+	if err != nil {
+		return nil, err
+	}
+	return loadersOf(p.Context).categories.Load(p.Context, id), nil
+}
+
+func (resolver *resolvers) searchAdverts(p graphql.ResolveParams) (interface{}, error) {
+	size, err := sizeArg(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	page, _ := p.Args["page"].(int)
+	if page < 0 {
+		return nil, custom_error.BadRequestErr("page must not be negative")
+	}
+	if err := validation.ResultWindow(page, size); err != nil {
+		return nil, err
+	}
+	query := &queries.SearchAdvertsQuery{Page: page, Size: size}
+	query.Query, _ = p.Args["q"].(string)
+	if categoryId, exists := p.Args["categoryId"]; exists {
+		if query.CategoryId, err = idArg(categoryId); err != nil {
+			return nil, err
+		}
+	}
+	searchResponse, err := resolver.queryHandler.SearchAdverts.Handle(p.Context, query)
+	if err != nil {
+		return nil, err
+	}
+	return primeAdverts(p, searchResponse.Adverts), nil
+}
+
+func (resolver *resolvers) advertCategory(p graphql.ResolveParams) (interface{}, error) {
+	advert := p.Source.(*model_api.AdvertResponse)
+	return loadersOf(p.Context).categories.Load(p.Context, advert.Category.Id), nil
+}
+
+func (resolver *resolvers) advertSiblings(p graphql.ResolveParams) (interface{}, error) {
+	advert := p.Source.(*model_api.AdvertResponse)
+	size, err := sizeArg(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	// one more advert is fetched in case the advert itself is on the page
+	categoryAdvertsResponse, err := resolver.queryHandler.GetCategoryAdverts.Handle(p.Context, &queries.GetCategoryAdvertsQuery{
+		CategoryId: advert.Category.Id,
+		Size:       size + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	siblings := make([]model_api.AdvertResponse, 0, size)
+	for _, sibling := range categoryAdvertsResponse.Adverts {
+		if sibling.Id != advert.Id && len(siblings) < size {
+			siblings = append(siblings, sibling)
+		}
+	}
+	return primeAdverts(p, siblings), nil
+}
+
+func (resolver *resolvers) similarAdverts(p graphql.ResolveParams) (interface{}, error) {
+	advert := p.Source.(*model_api.AdvertResponse)
+	size, err := sizeArg(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	similarAdvertsResponse, err := resolver.queryHandler.GetSimilarAdverts.Handle(p.Context, &queries.GetSimilarAdvertsQuery{
+		Id:   advert.Id,
+		Size: size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return primeAdverts(p, similarAdvertsResponse.Adverts), nil
+}
+
+func (resolver *resolvers) categoryAdverts(p graphql.ResolveParams) (interface{}, error) {
+	category := p.Source.(*model_api.CategoryResponse)
+	size, err := sizeArg(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	categoryAdvertsResponse, err := resolver.queryHandler.GetCategoryAdverts.Handle(p.Context, &queries.GetCategoryAdvertsQuery{
+		CategoryId: category.Id,
+		Size:       size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return primeAdverts(p, categoryAdvertsResponse.Adverts), nil
+}
+
+func (resolver *resolvers) categoryAncestors(p graphql.ResolveParams) (interface{}, error) {
+	category := p.Source.(*model_api.CategoryResponse)
+	categoryAncestorsResponse, err := resolver.queryHandler.GetCategoryAncestors.Handle(p.Context, &queries.GetCategoryAncestorsQuery{
+		Id: category.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	categoryLoader := loadersOf(p.Context).categories
+	ancestors := make([]*model_api.CategoryResponse, 0, len(categoryAncestorsResponse.Ancestors))
+	for i := range categoryAncestorsResponse.Ancestors {
+		ancestor := &categoryAncestorsResponse.Ancestors[i]
+		categoryLoader.Prime(ancestor.Id, ancestor)
+		ancestors = append(ancestors, ancestor)
+	}
+	return ancestors, nil
+}
+
+// primeAdverts returns the adverts as the pointers resolvers expect and stores them in the advert loader
+func primeAdverts(p graphql.ResolveParams, adverts []model_api.AdvertResponse) []*model_api.AdvertResponse {
+	advertLoader := loadersOf(p.Context).adverts
+	advertPointers := make([]*model_api.AdvertResponse, 0, len(adverts))
+	for i := range adverts {
+		advertLoader.Prime(adverts[i].Id, &adverts[i])
+		advertPointers = append(advertPointers, &adverts[i])
+	}
+	return advertPointers
+}
+
+func idArg(value interface{}) (int64, error) {
+	idStr, _ := value.(string)
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		return 0, custom_error.BadRequestErr("id must be number")
+	}
+	return id, nil
+}
+
+func sizeArg(args map[string]interface{}) (int, error) {
+	size, _ := args[sizeArgument].(int)
+	if size < 1 || size > maxListSize {
+		return 0, custom_error.BadRequestErrWithArgs("size must be between 1 and %d", maxListSize)
+	}
+	return size, nil
+}
@@ -0,0 +1,620 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: advert_read_api.proto
+
+package advertpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetAdvertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdvertRequest) Reset() {
+	*x = GetAdvertRequest{}
+	mi := &file_advert_read_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdvertRequest) ProtoMessage() {}
+
+func (x *GetAdvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_advert_read_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdvertRequest.ProtoReflect.Descriptor instead.
+func (*GetAdvertRequest) Descriptor() ([]byte, []int) {
+	return file_advert_read_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAdvertRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryRequest) Reset() {
+	*x = GetCategoryRequest{}
+	mi := &file_advert_read_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryRequest) ProtoMessage() {}
+
+func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_advert_read_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_advert_read_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// SearchAdvertsRequest has the filters of GET /adverts, dates are yyyy-MM-dd or RFC 3339 and size defaults to 20
+type SearchAdvertsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Query            string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId       int64                  `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CreationDateFrom string                 `protobuf:"bytes,3,opt,name=creation_date_from,json=creationDateFrom,proto3" json:"creation_date_from,omitempty"`
+	CreationDateTo   string                 `protobuf:"bytes,4,opt,name=creation_date_to,json=creationDateTo,proto3" json:"creation_date_to,omitempty"`
+	Page             int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Size             int32                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SearchAdvertsRequest) Reset() {
+	*x = SearchAdvertsRequest{}
+	mi := &file_advert_read_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAdvertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAdvertsRequest) ProtoMessage() {}
+
+func (x *SearchAdvertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_advert_read_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAdvertsRequest.ProtoReflect.Descriptor instead.
+func (*SearchAdvertsRequest) Descriptor() ([]byte, []int) {
+	return file_advert_read_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchAdvertsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchAdvertsRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *SearchAdvertsRequest) GetCreationDateFrom() string {
+	if x != nil {
+		return x.CreationDateFrom
+	}
+	return ""
+}
+
+func (x *SearchAdvertsRequest) GetCreationDateTo() string {
+	if x != nil {
+		return x.CreationDateTo
+	}
+	return ""
+}
+
+func (x *SearchAdvertsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchAdvertsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type SearchAdvertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adverts       []*Advert              `protobuf:"bytes,1,rep,name=adverts,proto3" json:"adverts,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAdvertsResponse) Reset() {
+	*x = SearchAdvertsResponse{}
+	mi := &file_advert_read_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAdvertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAdvertsResponse) ProtoMessage() {}
+
+func (x *SearchAdvertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_advert_read_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAdvertsResponse.ProtoReflect.Descriptor instead.
+func (*SearchAdvertsResponse) Descriptor() ([]byte, []int) {
+	return file_advert_read_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SearchAdvertsResponse) GetAdverts() []*Advert {
+	if x != nil {
+		return x.Adverts
+	}
+	return nil
+}
+
+func (x *SearchAdvertsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchAdvertsResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SearchAdvertsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type Advert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category      *AdvertCategory        `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Location      *GeoPoint              `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	District      string                 `protobuf:"bytes,7,opt,name=district,proto3" json:"district,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Advert) Reset() {
+	*x = Advert{}
+	mi := &file_advert_read_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Advert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Advert) ProtoMessage() {}
+
+func (x *Advert) ProtoReflect() protoreflect.Message {
+	mi := &file_advert_read_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Advert.ProtoReflect.Descriptor instead.
+func (*Advert) Descriptor() ([]byte, []int) {
+	return file_advert_read_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Advert) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Advert) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Advert) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Advert) GetCategory() *AdvertCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *Advert) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Advert) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Advert) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+type AdvertCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdvertCategory) Reset() {
+	*x = AdvertCategory{}
+	mi := &file_advert_read_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdvertCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvertCategory) ProtoMessage() {}
+
+func (x *AdvertCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_advert_read_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvertCategory.ProtoReflect.Descriptor instead.
+func (*AdvertCategory) Descriptor() ([]byte, []int) {
+	return file_advert_read_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AdvertCategory) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdvertCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GeoPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lat           float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon           float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	mi := &file_advert_read_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_advert_read_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_advert_read_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GeoPoint) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_advert_read_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_advert_read_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_advert_read_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Category) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_advert_read_api_proto protoreflect.FileDescriptor
+
+const file_advert_read_api_proto_rawDesc = "" +
+	"\n" +
+	"\x15advert_read_api.proto\x12\x0eadvert.read.v1\"\"\n" +
+	"\x10GetAdvertRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"$\n" +
+	"\x12GetCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xcd\x01\n" +
+	"\x14SearchAdvertsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\x03R\n" +
+	"categoryId\x12,\n" +
+	"\x12creation_date_from\x18\x03 \x01(\tR\x10creationDateFrom\x12(\n" +
+	"\x10creation_date_to\x18\x04 \x01(\tR\x0ecreationDateTo\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x05R\x04size\"\x92\x01\n" +
+	"\x15SearchAdvertsResponse\x120\n" +
+	"\aadverts\x18\x01 \x03(\v2\x16.advert.read.v1.AdvertR\aadverts\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x05R\x04size\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x03R\n" +
+	"totalCount\"\xf2\x01\n" +
+	"\x06Advert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12:\n" +
+	"\bcategory\x18\x04 \x01(\v2\x1e.advert.read.v1.AdvertCategoryR\bcategory\x124\n" +
+	"\blocation\x18\x05 \x01(\v2\x18.advert.read.v1.GeoPointR\blocation\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x12\x1a\n" +
+	"\bdistrict\x18\a \x01(\tR\bdistrict\"4\n" +
+	"\x0eAdvertCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\".\n" +
+	"\bGeoPoint\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x02 \x01(\x01R\x03lon\".\n" +
+	"\bCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name2\x85\x02\n" +
+	"\x11AdvertReadService\x12E\n" +
+	"\tGetAdvert\x12 .advert.read.v1.GetAdvertRequest\x1a\x16.advert.read.v1.Advert\x12K\n" +
+	"\vGetCategory\x12\".advert.read.v1.GetCategoryRequest\x1a\x18.advert.read.v1.Category\x12\\\n" +
+	"\rSearchAdverts\x12$.advert.read.v1.SearchAdvertsRequest\x1a%.advert.read.v1.SearchAdvertsResponseB?Z=presentation-advert-read-api/infrastructure/grpc_api/advertpbb\x06proto3"
+
+var (
+	file_advert_read_api_proto_rawDescOnce sync.Once
+	file_advert_read_api_proto_rawDescData []byte
+)
+
+func file_advert_read_api_proto_rawDescGZIP() []byte {
+	file_advert_read_api_proto_rawDescOnce.Do(func() {
+		file_advert_read_api_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_advert_read_api_proto_rawDesc), len(file_advert_read_api_proto_rawDesc)))
+	})
+	return file_advert_read_api_proto_rawDescData
+}
+
+var file_advert_read_api_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_advert_read_api_proto_goTypes = []any{
+	(*GetAdvertRequest)(nil),      // 0: advert.read.v1.GetAdvertRequest
+	(*GetCategoryRequest)(nil),    // 1: advert.read.v1.GetCategoryRequest
+	(*SearchAdvertsRequest)(nil),  // 2: advert.read.v1.SearchAdvertsRequest
+	(*SearchAdvertsResponse)(nil), // 3: advert.read.v1.SearchAdvertsResponse
+	(*Advert)(nil),                // 4: advert.read.v1.Advert
+	(*AdvertCategory)(nil),        // 5: advert.read.v1.AdvertCategory
+	(*GeoPoint)(nil),              // 6: advert.read.v1.GeoPoint
+	(*Category)(nil),              // 7: advert.read.v1.Category
+}
+var file_advert_read_api_proto_depIdxs = []int32{
+	4, // 0: advert.read.v1.SearchAdvertsResponse.adverts:type_name -> advert.read.v1.Advert
+	5, // 1: advert.read.v1.Advert.category:type_name -> advert.read.v1.AdvertCategory
+	6, // 2: advert.read.v1.Advert.location:type_name -> advert.read.v1.GeoPoint
+	0, // 3: advert.read.v1.AdvertReadService.GetAdvert:input_type -> advert.read.v1.GetAdvertRequest
+	1, // 4: advert.read.v1.AdvertReadService.GetCategory:input_type -> advert.read.v1.GetCategoryRequest
+	2, // 5: advert.read.v1.AdvertReadService.SearchAdverts:input_type -> advert.read.v1.SearchAdvertsRequest
+	4, // 6: advert.read.v1.AdvertReadService.GetAdvert:output_type -> advert.read.v1.Advert
+	7, // 7: advert.read.v1.AdvertReadService.GetCategory:output_type -> advert.read.v1.Category
+	3, // 8: advert.read.v1.AdvertReadService.SearchAdverts:output_type -> advert.read.v1.SearchAdvertsResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_advert_read_api_proto_init() }
+func file_advert_read_api_proto_init() {
+	if File_advert_read_api_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_advert_read_api_proto_rawDesc), len(file_advert_read_api_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_advert_read_api_proto_goTypes,
+		DependencyIndexes: file_advert_read_api_proto_depIdxs,
+		MessageInfos:      file_advert_read_api_proto_msgTypes,
+	}.Build()
+	File_advert_read_api_proto = out.File
+	file_advert_read_api_proto_goTypes = nil
+	file_advert_read_api_proto_depIdxs = nil
+}
@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: advert_read_api.proto
+
+package advertpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdvertReadService_GetAdvert_FullMethodName     = "/advert.read.v1.AdvertReadService/GetAdvert"
+	AdvertReadService_GetCategory_FullMethodName   = "/advert.read.v1.AdvertReadService/GetCategory"
+	AdvertReadService_SearchAdverts_FullMethodName = "/advert.read.v1.AdvertReadService/SearchAdverts"
+)
+
+// AdvertReadServiceClient is the client API for AdvertReadService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdvertReadService mirrors the REST read endpoints
+type AdvertReadServiceClient interface {
+	GetAdvert(ctx context.Context, in *GetAdvertRequest, opts ...grpc.CallOption) (*Advert, error)
+	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	SearchAdverts(ctx context.Context, in *SearchAdvertsRequest, opts ...grpc.CallOption) (*SearchAdvertsResponse, error)
+}
+
+type advertReadServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdvertReadServiceClient(cc grpc.ClientConnInterface) AdvertReadServiceClient {
+	return &advertReadServiceClient{cc}
+}
+
+func (c *advertReadServiceClient) GetAdvert(ctx context.Context, in *GetAdvertRequest, opts ...grpc.CallOption) (*Advert, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Advert)
+	err := c.cc.Invoke(ctx, AdvertReadService_GetAdvert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advertReadServiceClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, AdvertReadService_GetCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *advertReadServiceClient) SearchAdverts(ctx context.Context, in *SearchAdvertsRequest, opts ...grpc.CallOption) (*SearchAdvertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAdvertsResponse)
+	err := c.cc.Invoke(ctx, AdvertReadService_SearchAdverts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdvertReadServiceServer is the server API for AdvertReadService service.
+// All implementations must embed UnimplementedAdvertReadServiceServer
+// for forward compatibility.
+//
+// AdvertReadService mirrors the REST read endpoints
+type AdvertReadServiceServer interface {
+	GetAdvert(context.Context, *GetAdvertRequest) (*Advert, error)
+	GetCategory(context.Context, *GetCategoryRequest) (*Category, error)
+	SearchAdverts(context.Context, *SearchAdvertsRequest) (*SearchAdvertsResponse, error)
+	mustEmbedUnimplementedAdvertReadServiceServer()
+}
+
+// UnimplementedAdvertReadServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdvertReadServiceServer struct{}
+
+func (UnimplementedAdvertReadServiceServer) GetAdvert(context.Context, *GetAdvertRequest) (*Advert, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdvert not implemented")
+}
+func (UnimplementedAdvertReadServiceServer) GetCategory(context.Context, *GetCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedAdvertReadServiceServer) SearchAdverts(context.Context, *SearchAdvertsRequest) (*SearchAdvertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAdverts not implemented")
+}
+func (UnimplementedAdvertReadServiceServer) mustEmbedUnimplementedAdvertReadServiceServer() {}
+func (UnimplementedAdvertReadServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAdvertReadServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdvertReadServiceServer will
+// result in compilation errors.
+type UnsafeAdvertReadServiceServer interface {
+	mustEmbedUnimplementedAdvertReadServiceServer()
+}
+
+func RegisterAdvertReadServiceServer(s grpc.ServiceRegistrar, srv AdvertReadServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdvertReadServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdvertReadService_ServiceDesc, srv)
+}
+
+func _AdvertReadService_GetAdvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdvertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvertReadServiceServer).GetAdvert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvertReadService_GetAdvert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvertReadServiceServer).GetAdvert(ctx, req.(*GetAdvertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvertReadService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvertReadServiceServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvertReadService_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvertReadServiceServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdvertReadService_SearchAdverts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAdvertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdvertReadServiceServer).SearchAdverts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdvertReadService_SearchAdverts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdvertReadServiceServer).SearchAdverts(ctx, req.(*SearchAdvertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdvertReadService_ServiceDesc is the grpc.ServiceDesc for AdvertReadService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdvertReadService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "advert.read.v1.AdvertReadService",
+	HandlerType: (*AdvertReadServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAdvert",
+			Handler:    _AdvertReadService_GetAdvert_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _AdvertReadService_GetCategory_Handler,
+		},
+		{
+			MethodName: "SearchAdverts",
+			Handler:    _AdvertReadService_SearchAdverts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "advert_read_api.proto",
+}
@@ -0,0 +1,55 @@
+package grpc_api
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+)
+
+// errorInterceptor turns the errors of the handlers into grpc status errors
+func errorInterceptor(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	response, err := handler(ctx, request)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return response, nil
+}
+
+func toStatusError(err error) error {
+	if _, isStatus := status.FromError(err); isStatus {
+		return err
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	var customError *custom_error.CustomError
+	if errors.As(err, &customError) {
+		return status.Error(statusCode(customError.Status), customError.Detail)
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func statusCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	return codes.Internal
+}
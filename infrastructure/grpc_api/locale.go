@@ -0,0 +1,20 @@
+package grpc_api
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
+	"strings"
+)
+
+// localeInterceptor resolves the language chain from the accept-language metadata like the http middleware
+func localeInterceptor(config *locale.Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var acceptLanguage string
+		if md, exists := metadata.FromIncomingContext(ctx); exists {
+			acceptLanguage = strings.Join(md.Get(locale.AcceptLanguageHeader), ",")
+		}
+		return handler(locale.WithLanguages(ctx, locale.Chain(config, "", acceptLanguage)), request)
+	}
+}
@@ -0,0 +1,119 @@
+package grpc_api
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
+	"presentation-advert-read-api/infrastructure/grpc_api/advertpb"
+	"presentation-advert-read-api/infrastructure/validation"
+	"presentation-advert-read-api/model/model_api"
+)
+
+//go:generate protoc -I proto --go_out=advertpb --go_opt=paths=source_relative --go-grpc_out=advertpb --go-grpc_opt=paths=source_relative advert_read_api.proto
+
+type advertReadServer struct {
+	advertpb.UnimplementedAdvertReadServiceServer
+	queryHandler *handlers.QueryHandler
+}
+
+// NewServer registers the advert read service together with the standard health and reflection services
+func NewServer(queryHandler *handlers.QueryHandler, localeConfig *locale.Config) *grpc.Server {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(errorInterceptor, localeInterceptor(localeConfig)))
+	advertpb.RegisterAdvertReadServiceServer(server, &advertReadServer{queryHandler: queryHandler})
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(advertpb.AdvertReadService_ServiceDesc.ServiceName, grpc_health_v1.HealthCheckResponse_SERVING)
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
+	return server
+}
+
+func (server *advertReadServer) GetAdvert(ctx context.Context, request *advertpb.GetAdvertRequest) (*advertpb.Advert, error) {
+	advertResponse, err := server.queryHandler.GetAdvert.Handle(ctx, &queries.GetAdvertQuery{Id: request.GetId()})
+	if err != nil {
+		return nil, err
+	}
+	return toAdvert(advertResponse), nil
+}
+
+func (server *advertReadServer) GetCategory(ctx context.Context, request *advertpb.GetCategoryRequest) (*advertpb.Category, error) {
+	categoryResponse, err := server.queryHandler.GetCategory.Handle(ctx, &queries.GetCategoryQuery{Id: request.GetId()})
+	if err != nil {
+		return nil, err
+	}
+	return &advertpb.Category{
+		Id:   categoryResponse.Id,
+		Name: categoryResponse.Name,
+	}, nil
+}
+
+func (server *advertReadServer) SearchAdverts(ctx context.Context, request *advertpb.SearchAdvertsRequest) (*advertpb.SearchAdvertsResponse, error) {
+	size := int(request.GetSize())
+	if size == 0 {
+		size = validation.DefaultPageSize
+	}
+	if err := validation.PageSize(size); err != nil {
+		return nil, err
+	}
+	page := int(request.GetPage())
+	if err := validation.Page(page); err != nil {
+		return nil, err
+	}
+	if err := validation.ResultWindow(page, size); err != nil {
+		return nil, err
+	}
+	if err := validation.Date("creation_date_from", request.GetCreationDateFrom()); err != nil {
+		return nil, err
+	}
+	if err := validation.Date("creation_date_to", request.GetCreationDateTo()); err != nil {
+		return nil, err
+	}
+	searchResponse, err := server.queryHandler.SearchAdverts.Handle(ctx, &queries.SearchAdvertsQuery{
+		AdvertFilter: queries.AdvertFilter{
+			Query:            request.GetQuery(),
+			CategoryId:       request.GetCategoryId(),
+			CreationDateFrom: request.GetCreationDateFrom(),
+			CreationDateTo:   request.GetCreationDateTo(),
+		},
+		Page: page,
+		Size: size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	adverts := make([]*advertpb.Advert, 0, len(searchResponse.Adverts))
+	for i := range searchResponse.Adverts {
+		adverts = append(adverts, toAdvert(&searchResponse.Adverts[i]))
+	}
+	return &advertpb.SearchAdvertsResponse{
+		Adverts:    adverts,
+		Page:       int32(searchResponse.Page),
+		Size:       int32(searchResponse.Size),
+		TotalCount: searchResponse.TotalCount,
+	}, nil
+}
+
+func toAdvert(advertResponse *model_api.AdvertResponse) *advertpb.Advert {
+	advert := &advertpb.Advert{
+		Id:          advertResponse.Id,
+		Title:       advertResponse.Title,
+		Description: advertResponse.Description,
+		Category: &advertpb.AdvertCategory{
+			Id:   advertResponse.Category.Id,
+			Name: advertResponse.Category.Name,
+		},
+		City:     advertResponse.City,
+		District: advertResponse.District,
+	}
+	if advertResponse.Location != nil {
+		advert.Location = &advertpb.GeoPoint{
+			Lat: advertResponse.Location.Lat,
+			Lon: advertResponse.Location.Lon,
+		}
+	}
+	return advert
+}
@@ -4,6 +4,7 @@ import (
 	"presentation-advert-read-api/application/handlers"
 	"presentation-advert-read-api/application/repository"
 	"presentation-advert-read-api/application/tracers"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
 	"presentation-advert-read-api/infrastructure/handlers/query_handlers"
 	infraTracers "presentation-advert-read-api/infrastructure/tracers"
 )
@@ -15,12 +16,63 @@ func InitializeQueryHandler(
 	tracer := []tracers.Tracer{
 		infraTracers.NewExampleTracer(),
 	}
+	// concurrent identical queries share one call, exporting writes to the response of its caller so it is not coalesced
+	coalescing := handlers.WithCoalescing(locale.ContextKey)
 	commandHandler := &handlers.QueryHandler{}
+	commandHandler.AdvertExists = handlers.NewQueryHandlerDecorator(query_handlers.NewAdvertExistsQueryHandler(
+		advertRepository,
+	), tracer, coalescing)
 	commandHandler.GetAdvert = handlers.NewQueryHandlerDecorator(query_handlers.NewGetAdvertQueryHandler(
 		advertRepository,
+	), tracer, coalescing)
+	commandHandler.GetAdvertFull = handlers.NewQueryHandlerDecorator(query_handlers.NewGetAdvertFullQueryHandler(
+		advertRepository,
+	), tracer, coalescing)
+	commandHandler.GetAdverts = handlers.NewQueryHandlerDecorator(query_handlers.NewGetAdvertsQueryHandler(
+		advertRepository,
+	), tracer, coalescing)
+	commandHandler.CountAdverts = handlers.NewQueryHandlerDecorator(query_handlers.NewCountAdvertsQueryHandler(
+		advertRepository,
+	), tracer, coalescing)
+	commandHandler.GetAdvertChanges = handlers.NewQueryHandlerDecorator(query_handlers.NewGetAdvertChangesQueryHandler(
+		advertRepository,
+	), tracer, coalescing)
+	commandHandler.ExportAdverts = handlers.NewQueryHandlerDecorator(query_handlers.NewExportAdvertsQueryHandler(
+		advertRepository,
 	), tracer)
+	commandHandler.GetSimilarAdverts = handlers.NewQueryHandlerDecorator(query_handlers.NewGetSimilarAdvertsQueryHandler(
+		advertRepository,
+	), tracer, coalescing)
+	commandHandler.SearchAdverts = handlers.NewQueryHandlerDecorator(query_handlers.NewSearchAdvertsQueryHandler(
+		advertRepository,
+	), tracer, coalescing)
+	commandHandler.Suggest = handlers.NewQueryHandlerDecorator(query_handlers.NewSuggestQueryHandler(
+		advertRepository,
+		categoryRepository,
+	), tracer, coalescing)
+	commandHandler.CategoryExists = handlers.NewQueryHandlerDecorator(query_handlers.NewCategoryExistsQueryHandler(
+		categoryRepository,
+	), tracer, coalescing)
 	commandHandler.GetCategory = handlers.NewQueryHandlerDecorator(query_handlers.NewGetCategoryQueryHandler(
-		categoryRepository),
-		tracer)
+		categoryRepository,
+	), tracer, coalescing)
+	commandHandler.GetCategoryFull = handlers.NewQueryHandlerDecorator(query_handlers.NewGetCategoryFullQueryHandler(
+		categoryRepository,
+	), tracer, coalescing)
+	commandHandler.GetCategories = handlers.NewQueryHandlerDecorator(query_handlers.NewGetCategoriesQueryHandler(
+		categoryRepository,
+	), tracer, coalescing)
+	commandHandler.ListCategories = handlers.NewQueryHandlerDecorator(query_handlers.NewListCategoriesQueryHandler(
+		categoryRepository,
+	), tracer, coalescing)
+	commandHandler.GetCategoryTree = handlers.NewQueryHandlerDecorator(query_handlers.NewGetCategoryTreeQueryHandler(
+		categoryRepository,
+	), tracer, coalescing)
+	commandHandler.GetCategoryAncestors = handlers.NewQueryHandlerDecorator(query_handlers.NewGetCategoryAncestorsQueryHandler(
+		categoryRepository,
+	), tracer, coalescing)
+	commandHandler.GetCategoryAdverts = handlers.NewQueryHandlerDecorator(query_handlers.NewGetCategoryAdvertsQueryHandler(
+		advertRepository,
+	), tracer, coalescing)
 	return commandHandler, nil
 }
@@ -0,0 +1,24 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+)
+
+type advertExistsQueryHandler struct {
+	advertRepository repository.AdvertRepository
+}
+
+func NewAdvertExistsQueryHandler(
+	advertRepository repository.AdvertRepository,
+) handlers.QueryHandlerInterface[*queries.AdvertExistsQuery, bool] {
+	return &advertExistsQueryHandler{
+		advertRepository: advertRepository,
+	}
+}
+
+func (handler *advertExistsQueryHandler) Handle(ctx context.Context, query *queries.AdvertExistsQuery) (bool, error) {
+	return handler.advertRepository.ExistsById(ctx, query.Id)
+}
@@ -0,0 +1,24 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+)
+
+type categoryExistsQueryHandler struct {
+	categoryRepository repository.CategoryRepository
+}
+
+func NewCategoryExistsQueryHandler(
+	categoryRepository repository.CategoryRepository,
+) handlers.QueryHandlerInterface[*queries.CategoryExistsQuery, bool] {
+	return &categoryExistsQueryHandler{
+		categoryRepository: categoryRepository,
+	}
+}
+
+func (handler *categoryExistsQueryHandler) Handle(ctx context.Context, query *queries.CategoryExistsQuery) (bool, error) {
+	return handler.categoryRepository.ExistsById(ctx, query.Id)
+}
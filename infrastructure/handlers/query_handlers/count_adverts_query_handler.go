@@ -0,0 +1,32 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+)
+
+type countAdvertsQueryHandler struct {
+	advertRepository repository.AdvertRepository
+}
+
+func NewCountAdvertsQueryHandler(
+	advertRepository repository.AdvertRepository,
+) handlers.QueryHandlerInterface[*queries.CountAdvertsQuery, *model_api.AdvertCountResponse] {
+	return &countAdvertsQueryHandler{
+		advertRepository: advertRepository,
+	}
+}
+
+func (handler *countAdvertsQueryHandler) Handle(ctx context.Context, query *queries.CountAdvertsQuery) (*model_api.AdvertCountResponse, error) {
+	count, err := handler.advertRepository.Count(ctx, toAdvertFilter(&query.AdvertFilter), query.Exact)
+	if err != nil {
+		return nil, err
+	}
+	return &model_api.AdvertCountResponse{
+		Count:    count.Count,
+		Relation: string(count.Relation),
+	}, nil
+}
@@ -0,0 +1,39 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+	"presentation-advert-read-api/model/model_repository"
+)
+
+type exportAdvertsQueryHandler struct {
+	advertRepository repository.AdvertRepository
+}
+
+func NewExportAdvertsQueryHandler(
+	advertRepository repository.AdvertRepository,
+) handlers.QueryHandlerInterface[*queries.ExportAdvertsQuery, int64] {
+	return &exportAdvertsQueryHandler{
+		advertRepository: advertRepository,
+	}
+}
+
+// Handle streams the adverts to query.Write and returns the exported advert count
+func (handler *exportAdvertsQueryHandler) Handle(ctx context.Context, query *queries.ExportAdvertsQuery) (int64, error) {
+	var count int64
+	err := handler.advertRepository.ScrollByCategoryId(ctx, query.CategoryId, func(adverts []*model_repository.Advert) error {
+		advertResponses := make([]*model_api.AdvertResponse, 0, len(adverts))
+		for _, advert := range adverts {
+			advertResponses = append(advertResponses, toAdvertResponse(ctx, advert))
+		}
+		if err := query.Write(advertResponses); err != nil {
+			return err
+		}
+		count += int64(len(advertResponses))
+		return nil
+	})
+	return count, err
+}
@@ -0,0 +1,46 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+)
+
+type getAdvertChangesQueryHandler struct {
+	advertRepository repository.AdvertRepository
+}
+
+func NewGetAdvertChangesQueryHandler(
+	advertRepository repository.AdvertRepository,
+) handlers.QueryHandlerInterface[*queries.GetAdvertChangesQuery, *model_api.AdvertChangesResponse] {
+	return &getAdvertChangesQueryHandler{
+		advertRepository: advertRepository,
+	}
+}
+
+func (handler *getAdvertChangesQueryHandler) Handle(ctx context.Context, query *queries.GetAdvertChangesQuery) (*model_api.AdvertChangesResponse, error) {
+	result, err := handler.advertRepository.GetChanges(ctx, query.Since, query.Checkpoint, query.Size)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]model_api.AdvertChangeResponse, 0, len(result.Adverts))
+	for _, advert := range result.Adverts {
+		change := model_api.AdvertChangeResponse{
+			Id:               advert.Id,
+			Version:          advert.Version,
+			LastModifiedDate: advert.LastModifiedDate,
+			Deleted:          advert.Deleted,
+		}
+		if !advert.Deleted {
+			change.Advert = toAdvertResponse(ctx, advert)
+		}
+		changes = append(changes, change)
+	}
+	return &model_api.AdvertChangesResponse{
+		Changes:    changes,
+		Checkpoint: result.Checkpoint,
+		HasMore:    result.HasMore,
+	}, nil
+}
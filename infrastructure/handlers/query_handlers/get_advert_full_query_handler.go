@@ -0,0 +1,36 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+)
+
+type getAdvertFullQueryHandler struct {
+	advertRepository repository.AdvertRepository
+}
+
+func NewGetAdvertFullQueryHandler(
+	advertRepository repository.AdvertRepository,
+) handlers.QueryHandlerInterface[*queries.GetAdvertQuery, *model_api.AdvertFullResponse] {
+	return &getAdvertFullQueryHandler{
+		advertRepository: advertRepository,
+	}
+}
+
+func (handler *getAdvertFullQueryHandler) Handle(ctx context.Context, query *queries.GetAdvertQuery) (*model_api.AdvertFullResponse, error) {
+	advert, err := handler.advertRepository.GetById(ctx, query.Id, query.Fields...)
+	if err != nil {
+		return nil, err
+	}
+	return &model_api.AdvertFullResponse{
+		AdvertResponse:   *toAdvertResponse(ctx, advert),
+		Version:          advert.Version,
+		CreatedBy:        advert.CreatedBy,
+		CreationDate:     advert.CreationDate,
+		ModifiedBy:       advert.ModifiedBy,
+		LastModifiedDate: advert.LastModifiedDate,
+	}, nil
+}
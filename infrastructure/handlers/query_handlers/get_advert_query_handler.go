@@ -5,7 +5,9 @@ import (
 	"presentation-advert-read-api/application/handlers"
 	"presentation-advert-read-api/application/queries"
 	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
 	"presentation-advert-read-api/model/model_api"
+	"presentation-advert-read-api/model/model_repository"
 )
 
 type getAdvertQueryHandler struct {
@@ -21,17 +23,32 @@ func NewGetAdvertQueryHandler(
 }
 
 func (handler *getAdvertQueryHandler) Handle(ctx context.Context, query *queries.GetAdvertQuery) (*model_api.AdvertResponse, error) {
-	advert, err := handler.advertRepository.GetById(ctx, query.Id)
+	advert, err := handler.advertRepository.GetById(ctx, query.Id, query.Fields...)
 	if err != nil {
 		return nil, err
 	}
-	return &model_api.AdvertResponse{
+	return toAdvertResponse(ctx, advert), nil
+}
+
+func toAdvertResponse(ctx context.Context, advert *model_repository.Advert) *model_api.AdvertResponse {
+	advertResponse := &model_api.AdvertResponse{
 		Id:          advert.Id,
 		Title:       advert.Title,
 		Description: advert.Description,
 		Category: model_api.AdvertCategoryResponse{
 			Id:   advert.Category.Id,
-			Name: advert.Category.Name,
+			Name: locale.Name(ctx, advert.Category.Name, advert.Category.Names),
 		},
-	}, nil
+		City:             advert.City,
+		District:         advert.District,
+		Version:          advert.Version,
+		LastModifiedDate: advert.LastModifiedDate,
+	}
+	if advert.Location != nil {
+		advertResponse.Location = &model_api.GeoPointResponse{
+			Lat: advert.Location.Lat,
+			Lon: advert.Location.Lon,
+		}
+	}
+	return advertResponse
 }
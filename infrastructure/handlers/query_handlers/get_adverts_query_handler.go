@@ -0,0 +1,36 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+)
+
+type getAdvertsQueryHandler struct {
+	advertRepository repository.AdvertRepository
+}
+
+func NewGetAdvertsQueryHandler(
+	advertRepository repository.AdvertRepository,
+) handlers.QueryHandlerInterface[*queries.GetAdvertsQuery, *model_api.AdvertListResponse] {
+	return &getAdvertsQueryHandler{
+		advertRepository: advertRepository,
+	}
+}
+
+func (handler *getAdvertsQueryHandler) Handle(ctx context.Context, query *queries.GetAdvertsQuery) (*model_api.AdvertListResponse, error) {
+	adverts, missingIds, err := handler.advertRepository.GetByIds(ctx, query.Ids, query.Fields...)
+	if err != nil {
+		return nil, err
+	}
+	advertResponses := make([]model_api.AdvertResponse, 0, len(adverts))
+	for _, advert := range adverts {
+		advertResponses = append(advertResponses, *toAdvertResponse(ctx, advert))
+	}
+	return &model_api.AdvertListResponse{
+		Adverts:    advertResponses,
+		MissingIds: missingIds,
+	}, nil
+}
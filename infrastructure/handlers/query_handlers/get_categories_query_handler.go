@@ -0,0 +1,36 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+)
+
+type getCategoriesQueryHandler struct {
+	categoryRepository repository.CategoryRepository
+}
+
+func NewGetCategoriesQueryHandler(
+	categoryRepository repository.CategoryRepository,
+) handlers.QueryHandlerInterface[*queries.GetCategoriesQuery, *model_api.CategoryListResponse] {
+	return &getCategoriesQueryHandler{
+		categoryRepository: categoryRepository,
+	}
+}
+
+func (handler *getCategoriesQueryHandler) Handle(ctx context.Context, query *queries.GetCategoriesQuery) (*model_api.CategoryListResponse, error) {
+	categories, missingIds, err := handler.categoryRepository.GetByIds(ctx, query.Ids, query.Fields...)
+	if err != nil {
+		return nil, err
+	}
+	categoryResponses := make([]model_api.CategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		categoryResponses = append(categoryResponses, *toCategoryResponse(ctx, category))
+	}
+	return &model_api.CategoryListResponse{
+		Categories: categoryResponses,
+		MissingIds: missingIds,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+)
+
+type getCategoryAdvertsQueryHandler struct {
+	advertRepository repository.AdvertRepository
+}
+
+func NewGetCategoryAdvertsQueryHandler(
+	advertRepository repository.AdvertRepository,
+) handlers.QueryHandlerInterface[*queries.GetCategoryAdvertsQuery, *model_api.CategoryAdvertsResponse] {
+	return &getCategoryAdvertsQueryHandler{
+		advertRepository: advertRepository,
+	}
+}
+
+func (handler *getCategoryAdvertsQueryHandler) Handle(ctx context.Context, query *queries.GetCategoryAdvertsQuery) (*model_api.CategoryAdvertsResponse, error) {
+	result, err := handler.advertRepository.GetByCategoryId(ctx, query.CategoryId, query.Cursor, query.Size, query.Fields...)
+	if err != nil {
+		return nil, err
+	}
+	adverts := make([]model_api.AdvertResponse, 0, len(result.Adverts))
+	for _, advert := range result.Adverts {
+		adverts = append(adverts, *toAdvertResponse(ctx, advert))
+	}
+	return &model_api.CategoryAdvertsResponse{
+		Adverts: adverts,
+		Next:    result.NextCursor,
+	}, nil
+}
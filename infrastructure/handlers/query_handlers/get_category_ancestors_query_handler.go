@@ -0,0 +1,35 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+)
+
+type getCategoryAncestorsQueryHandler struct {
+	categoryRepository repository.CategoryRepository
+}
+
+func NewGetCategoryAncestorsQueryHandler(
+	categoryRepository repository.CategoryRepository,
+) handlers.QueryHandlerInterface[*queries.GetCategoryAncestorsQuery, *model_api.CategoryAncestorsResponse] {
+	return &getCategoryAncestorsQueryHandler{
+		categoryRepository: categoryRepository,
+	}
+}
+
+func (handler *getCategoryAncestorsQueryHandler) Handle(ctx context.Context, query *queries.GetCategoryAncestorsQuery) (*model_api.CategoryAncestorsResponse, error) {
+	categories, err := handler.categoryRepository.GetAncestors(ctx, query.Id)
+	if err != nil {
+		return nil, err
+	}
+	ancestors := make([]model_api.CategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		ancestors = append(ancestors, *toCategoryResponse(ctx, category))
+	}
+	return &model_api.CategoryAncestorsResponse{
+		Ancestors: ancestors,
+	}, nil
+}
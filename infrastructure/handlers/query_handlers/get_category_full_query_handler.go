@@ -0,0 +1,36 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+)
+
+type getCategoryFullQueryHandler struct {
+	categoryRepository repository.CategoryRepository
+}
+
+func NewGetCategoryFullQueryHandler(
+	categoryRepository repository.CategoryRepository,
+) handlers.QueryHandlerInterface[*queries.GetCategoryQuery, *model_api.CategoryFullResponse] {
+	return &getCategoryFullQueryHandler{
+		categoryRepository: categoryRepository,
+	}
+}
+
+func (handler *getCategoryFullQueryHandler) Handle(ctx context.Context, query *queries.GetCategoryQuery) (*model_api.CategoryFullResponse, error) {
+	category, err := handler.categoryRepository.GetById(ctx, query.Id, query.Fields...)
+	if err != nil {
+		return nil, err
+	}
+	return &model_api.CategoryFullResponse{
+		CategoryResponse: *toCategoryResponse(ctx, category),
+		Version:          category.Version,
+		CreatedBy:        category.CreatedBy,
+		CreationDate:     category.CreationDate,
+		ModifiedBy:       category.ModifiedBy,
+		LastModifiedDate: category.LastModifiedDate,
+	}, nil
+}
@@ -5,7 +5,9 @@ import (
 	"presentation-advert-read-api/application/handlers"
 	"presentation-advert-read-api/application/queries"
 	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
 	"presentation-advert-read-api/model/model_api"
+	"presentation-advert-read-api/model/model_repository"
 )
 
 type getCategoryQueryHandler struct {
@@ -21,12 +23,18 @@ func NewGetCategoryQueryHandler(
 }
 
 func (handler *getCategoryQueryHandler) Handle(ctx context.Context, query *queries.GetCategoryQuery) (*model_api.CategoryResponse, error) {
-	category, err := handler.categoryRepository.GetById(ctx, query.Id)
+	category, err := handler.categoryRepository.GetById(ctx, query.Id, query.Fields...)
 	if err != nil {
 		return nil, err
 	}
+	return toCategoryResponse(ctx, category), nil
+}
+
+func toCategoryResponse(ctx context.Context, category *model_repository.Category) *model_api.CategoryResponse {
 	return &model_api.CategoryResponse{
-		Id:   category.Id,
-		Name: category.Name,
-	}, nil
+		Id:               category.Id,
+		Name:             locale.Name(ctx, category.Name, category.Names),
+		Version:          category.Version,
+		LastModifiedDate: category.LastModifiedDate,
+	}
 }
@@ -0,0 +1,50 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
+	"presentation-advert-read-api/model/model_api"
+)
+
+type getCategoryTreeQueryHandler struct {
+	categoryRepository repository.CategoryRepository
+}
+
+func NewGetCategoryTreeQueryHandler(
+	categoryRepository repository.CategoryRepository,
+) handlers.QueryHandlerInterface[*queries.GetCategoryTreeQuery, *model_api.CategoryTreeResponse] {
+	return &getCategoryTreeQueryHandler{
+		categoryRepository: categoryRepository,
+	}
+}
+
+func (handler *getCategoryTreeQueryHandler) Handle(ctx context.Context, query *queries.GetCategoryTreeQuery) (*model_api.CategoryTreeResponse, error) {
+	categories, err := handler.categoryRepository.GetSubtree(ctx, query.Id)
+	if err != nil {
+		return nil, err
+	}
+	// categories are ordered by depth, so a parent is always added before its children
+	nodes := make(map[int64]*model_api.CategoryTreeResponse, len(categories))
+	var root *model_api.CategoryTreeResponse
+	for _, category := range categories {
+		node := &model_api.CategoryTreeResponse{
+			Id:       category.Id,
+			Name:     locale.Name(ctx, category.Name, category.Names),
+			ParentId: category.ParentId,
+			Depth:    category.Depth,
+			Children: make([]*model_api.CategoryTreeResponse, 0),
+		}
+		nodes[category.Id] = node
+		if category.Id == query.Id {
+			root = node
+			continue
+		}
+		if parent, exists := nodes[category.ParentId]; exists {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+	return root, nil
+}
@@ -0,0 +1,40 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+)
+
+type getSimilarAdvertsQueryHandler struct {
+	advertRepository repository.AdvertRepository
+}
+
+func NewGetSimilarAdvertsQueryHandler(
+	advertRepository repository.AdvertRepository,
+) handlers.QueryHandlerInterface[*queries.GetSimilarAdvertsQuery, *model_api.SimilarAdvertsResponse] {
+	return &getSimilarAdvertsQueryHandler{
+		advertRepository: advertRepository,
+	}
+}
+
+func (handler *getSimilarAdvertsQueryHandler) Handle(ctx context.Context, query *queries.GetSimilarAdvertsQuery) (*model_api.SimilarAdvertsResponse, error) {
+	// the source advert gives the category to boost, and a 404 when it does not exist
+	advert, err := handler.advertRepository.GetById(ctx, query.Id, "category.id")
+	if err != nil {
+		return nil, err
+	}
+	adverts, err := handler.advertRepository.GetSimilar(ctx, advert, query.Size)
+	if err != nil {
+		return nil, err
+	}
+	advertResponses := make([]model_api.AdvertResponse, 0, len(adverts))
+	for _, similarAdvert := range adverts {
+		advertResponses = append(advertResponses, *toAdvertResponse(ctx, similarAdvert))
+	}
+	return &model_api.SimilarAdvertsResponse{
+		Adverts: advertResponses,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+	"presentation-advert-read-api/model/model_repository"
+)
+
+type listCategoriesQueryHandler struct {
+	categoryRepository repository.CategoryRepository
+}
+
+func NewListCategoriesQueryHandler(
+	categoryRepository repository.CategoryRepository,
+) handlers.QueryHandlerInterface[*queries.ListCategoriesQuery, *model_api.CategorySearchResponse] {
+	return &listCategoriesQueryHandler{
+		categoryRepository: categoryRepository,
+	}
+}
+
+func (handler *listCategoriesQueryHandler) Handle(ctx context.Context, query *queries.ListCategoriesQuery) (*model_api.CategorySearchResponse, error) {
+	result, err := handler.categoryRepository.Search(ctx, &model_repository.CategorySearchCriteria{
+		NamePrefix: query.NamePrefix,
+		Ranges:     toRangeFilters(query.Ranges),
+		SortField:  model_repository.CategorySortField(query.Sort),
+		Descending: query.Descending,
+		Fields:     query.Fields,
+		From:       query.Page * query.Size,
+		Size:       query.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	categories := make([]model_api.CategoryResponse, 0, len(result.Categories))
+	for _, category := range result.Categories {
+		categories = append(categories, *toCategoryResponse(ctx, category))
+	}
+	return &model_api.CategorySearchResponse{
+		Categories: categories,
+		Page:       query.Page,
+		Size:       query.Size,
+		TotalCount: result.TotalCount,
+	}, nil
+}
@@ -0,0 +1,104 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
+	"presentation-advert-read-api/model/model_api"
+	"presentation-advert-read-api/model/model_repository"
+)
+
+type searchAdvertsQueryHandler struct {
+	advertRepository repository.AdvertRepository
+}
+
+func NewSearchAdvertsQueryHandler(
+	advertRepository repository.AdvertRepository,
+) handlers.QueryHandlerInterface[*queries.SearchAdvertsQuery, *model_api.AdvertSearchResponse] {
+	return &searchAdvertsQueryHandler{
+		advertRepository: advertRepository,
+	}
+}
+
+func (handler *searchAdvertsQueryHandler) Handle(ctx context.Context, query *queries.SearchAdvertsQuery) (*model_api.AdvertSearchResponse, error) {
+	facets := make([]model_repository.AdvertFacet, 0, len(query.Facets))
+	for _, facet := range query.Facets {
+		facets = append(facets, model_repository.AdvertFacet(facet))
+	}
+	result, err := handler.advertRepository.Search(ctx, &model_repository.AdvertSearchCriteria{
+		AdvertFilter:   *toAdvertFilter(&query.AdvertFilter),
+		Facets:         facets,
+		Fields:         query.Fields,
+		SortByDistance: query.Sort == queries.SortByDistance,
+		From:           query.Page * query.Size,
+		Size:           query.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	adverts := make([]model_api.AdvertResponse, 0, len(result.Adverts))
+	for _, advert := range result.Adverts {
+		advertResponse := toAdvertResponse(ctx, advert)
+		if distance, exists := result.DistancesKm[advert.Id]; exists {
+			advertResponse.Distance = &distance
+		}
+		adverts = append(adverts, *advertResponse)
+	}
+	return &model_api.AdvertSearchResponse{
+		Adverts:    adverts,
+		Facets:     toFacetResponses(ctx, result.Facets),
+		Page:       query.Page,
+		Size:       query.Size,
+		TotalCount: result.TotalCount,
+	}, nil
+}
+
+func toFacetResponses(ctx context.Context, facets map[model_repository.AdvertFacet][]model_repository.FacetBucket) map[string][]model_api.FacetBucketResponse {
+	if len(facets) == 0 {
+		return nil
+	}
+	facetResponses := make(map[string][]model_api.FacetBucketResponse, len(facets))
+	for facet, buckets := range facets {
+		bucketResponses := make([]model_api.FacetBucketResponse, 0, len(buckets))
+		for _, bucket := range buckets {
+			bucketResponses = append(bucketResponses, model_api.FacetBucketResponse{
+				Key:   bucket.Key,
+				Name:  locale.Name(ctx, bucket.Name, bucket.Names),
+				Count: bucket.Count,
+			})
+		}
+		facetResponses[string(facet)] = bucketResponses
+	}
+	return facetResponses
+}
+
+func toAdvertFilter(filter *queries.AdvertFilter) *model_repository.AdvertFilter {
+	advertFilter := &model_repository.AdvertFilter{
+		Text:             filter.Query,
+		CategoryId:       filter.CategoryId,
+		CreationDateFrom: filter.CreationDateFrom,
+		CreationDateTo:   filter.CreationDateTo,
+		Ranges:           toRangeFilters(filter.Ranges),
+	}
+	if filter.Near != nil {
+		advertFilter.Near = &model_repository.GeoDistance{
+			Origin:   model_repository.GeoPoint{Lat: filter.Near.Lat, Lon: filter.Near.Lon},
+			RadiusKm: filter.Near.Radius,
+		}
+	}
+	return advertFilter
+}
+
+func toRangeFilters(rangeFilters []queries.RangeFilter) []model_repository.RangeFilter {
+	filters := make([]model_repository.RangeFilter, 0, len(rangeFilters))
+	for _, rangeFilter := range rangeFilters {
+		filters = append(filters, model_repository.RangeFilter{
+			Field:    rangeFilter.Field,
+			Operator: model_repository.RangeOperator(rangeFilter.Operator),
+			Value:    rangeFilter.Value,
+		})
+	}
+	return filters
+}
@@ -0,0 +1,77 @@
+package query_handlers
+
+import (
+	"context"
+	"presentation-advert-read-api/application/handlers"
+	"presentation-advert-read-api/application/queries"
+	"presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/model/model_api"
+	"presentation-advert-read-api/model/model_repository"
+	"sort"
+	"strings"
+)
+
+const (
+	advertSuggestionType   = "advert"
+	categorySuggestionType = "category"
+)
+
+type suggestQueryHandler struct {
+	advertRepository   repository.AdvertRepository
+	categoryRepository repository.CategoryRepository
+}
+
+func NewSuggestQueryHandler(
+	advertRepository repository.AdvertRepository,
+	categoryRepository repository.CategoryRepository,
+) handlers.QueryHandlerInterface[*queries.SuggestQuery, *model_api.SuggestResponse] {
+	return &suggestQueryHandler{
+		advertRepository:   advertRepository,
+		categoryRepository: categoryRepository,
+	}
+}
+
+func (handler *suggestQueryHandler) Handle(ctx context.Context, query *queries.SuggestQuery) (*model_api.SuggestResponse, error) {
+	advertSuggestions, err := handler.advertRepository.SuggestTitles(ctx, query.Prefix, query.Size)
+	if err != nil {
+		return nil, err
+	}
+	categorySuggestions, err := handler.categoryRepository.SuggestNames(ctx, query.Prefix, query.Size)
+	if err != nil {
+		return nil, err
+	}
+	// the same text may come from both indexes or with a different case, only the best scored one is kept
+	suggestionMap := make(map[string]model_api.SuggestionResponse)
+	addSuggestions := func(suggestions []*model_repository.Suggestion, suggestionType string) {
+		for _, suggestion := range suggestions {
+			key := strings.ToLower(strings.TrimSpace(suggestion.Text))
+			if existing, exists := suggestionMap[key]; exists && existing.Score >= suggestion.Score {
+				continue
+			}
+			suggestionMap[key] = model_api.SuggestionResponse{
+				Text:  suggestion.Text,
+				Type:  suggestionType,
+				Score: suggestion.Score,
+			}
+		}
+	}
+	addSuggestions(categorySuggestions, categorySuggestionType)
+	addSuggestions(advertSuggestions, advertSuggestionType)
+
+	suggestions := make([]model_api.SuggestionResponse, 0, len(suggestionMap))
+	for _, suggestion := range suggestionMap {
+		suggestions = append(suggestions, suggestion)
+	}
+	sort.Slice(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Text < suggestions[j].Text
+	})
+	if len(suggestions) > query.Size {
+		suggestions = suggestions[:query.Size]
+	}
+	return &model_api.SuggestResponse{
+		Suggestions: suggestions,
+	}, nil
+}
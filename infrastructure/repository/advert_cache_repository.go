@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	appRepository "presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/infrastructure/configuration/cache"
+	"presentation-advert-read-api/model/model_repository"
+)
+
+// AdvertCacheRepository reads adverts by id through the cache of whole documents, every other call goes
+// to the wrapped repository and is observed, so older cached adverts are not returned
+type AdvertCacheRepository struct {
+	appRepository.AdvertRepository
+	cache *documentCache[model_repository.Advert]
+}
+
+// NewAdvertCacheRepository uses only the in-process cache when backend is nil
+func NewAdvertCacheRepository(advertRepository appRepository.AdvertRepository, config *cache.Config, backend cache.Backend) *AdvertCacheRepository {
+	return &AdvertCacheRepository{
+		AdvertRepository: advertRepository,
+		cache: newDocumentCache("adverts", config, backend,
+			func(advert *model_repository.Advert) int64 { return advert.Id },
+			func(advert *model_repository.Advert) int16 { return advert.Version }),
+	}
+}
+
+func (repository *AdvertCacheRepository) Save(ctx context.Context, model *model_repository.Advert) error {
+	if err := repository.AdvertRepository.Save(ctx, model); err != nil {
+		return err
+	}
+	repository.cache.remove(ctx, model.Id)
+	return nil
+}
+
+func (repository *AdvertCacheRepository) GetById(ctx context.Context, id int64, fields ...string) (*model_repository.Advert, error) {
+	return repository.cache.getById(ctx, id, fields, repository.AdvertRepository.GetById)
+}
+
+func (repository *AdvertCacheRepository) ExistsById(ctx context.Context, id int64) (bool, error) {
+	return repository.cache.existsById(ctx, id, repository.AdvertRepository.ExistsById)
+}
+
+func (repository *AdvertCacheRepository) GetByIds(ctx context.Context, ids []int64, fields ...string) ([]*model_repository.Advert, []int64, error) {
+	return repository.cache.getByIds(ctx, ids, fields, repository.AdvertRepository.GetByIds)
+}
+
+func (repository *AdvertCacheRepository) GetByCategoryId(ctx context.Context, categoryId int64, cursor string, size int, fields ...string) (*model_repository.AdvertCursorResult, error) {
+	result, err := repository.AdvertRepository.GetByCategoryId(ctx, categoryId, cursor, size, fields...)
+	if err != nil {
+		return nil, err
+	}
+	repository.observe(result.Adverts)
+	return result, nil
+}
+
+func (repository *AdvertCacheRepository) GetSimilar(ctx context.Context, advert *model_repository.Advert, size int) ([]*model_repository.Advert, error) {
+	adverts, err := repository.AdvertRepository.GetSimilar(ctx, advert, size)
+	if err != nil {
+		return nil, err
+	}
+	repository.observe(adverts)
+	return adverts, nil
+}
+
+// GetChanges also caches the tombstones of the feed as not found
+func (repository *AdvertCacheRepository) GetChanges(ctx context.Context, since string, checkpoint string, size int) (*model_repository.AdvertChangeResult, error) {
+	result, err := repository.AdvertRepository.GetChanges(ctx, since, checkpoint, size)
+	if err != nil {
+		return nil, err
+	}
+	for _, advert := range result.Adverts {
+		if advert.Deleted {
+			repository.cache.addDeleted(ctx, advert.Id)
+		}
+	}
+	repository.observe(result.Adverts)
+	return result, nil
+}
+
+func (repository *AdvertCacheRepository) Search(ctx context.Context, criteria *model_repository.AdvertSearchCriteria) (*model_repository.AdvertSearchResult, error) {
+	result, err := repository.AdvertRepository.Search(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+	repository.observe(result.Adverts)
+	return result, nil
+}
+
+// observe skips tombstones, the feed caches them as not found
+func (repository *AdvertCacheRepository) observe(adverts []*model_repository.Advert) {
+	for _, advert := range adverts {
+		if !advert.Deleted {
+			repository.cache.observe(advert)
+		}
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"presentation-advert-read-api/infrastructure/configuration/elastic"
 	"presentation-advert-read-api/infrastructure/configuration/elastic/elasticv7"
 	"presentation-advert-read-api/model/model_repository"
+	"sort"
+	"time"
 )
 
 type AdvertElasticRepository struct {
@@ -28,8 +30,473 @@ func (repository *AdvertElasticRepository) Save(ctx context.Context, model *mode
 	return repository.IndexDocument(ctx, &elastic.IndexDocument{Id: id, Routing: id, Body: model})
 }
 
-func (repository *AdvertElasticRepository) GetById(ctx context.Context, id int64) (*model_repository.Advert, error) {
-	return repository.BaseGenericRepository.GetById(ctx, fmt.Sprint(id), "")
+func (repository *AdvertElasticRepository) GetById(ctx context.Context, id int64, fields ...string) (*model_repository.Advert, error) {
+	documentId := fmt.Sprint(id)
+	advert, err := repository.BaseGenericRepository.GetById(ctx, documentId, "", sourceIncludes(fields)...)
+	if err != nil {
+		return nil, err
+	}
+	if advert.Deleted {
+		return nil, custom_error.NotFoundErrWithArgs("GetById, Document is deleted by id %s", documentId)
+	}
+	return advert, nil
+}
+
+// ExistsById counts the document without fetching its _source, a tombstone does not exist
+func (repository *AdvertElasticRepository) ExistsById(ctx context.Context, id int64) (bool, error) {
+	countResponse, err := repository.BaseGenericRepository.GetCount(ctx, elastic.EsObject{
+		"query": elastic.EsObject{
+			"bool": elastic.EsObject{
+				"filter": elastic.EsArray{
+					elastic.EsObject{"ids": elastic.EsObject{"values": elastic.EsArray{fmt.Sprint(id)}}},
+				},
+				"must_not": deletedQuery,
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	return countResponse.Count > 0, nil
+}
+
+// GetByCategoryId pages through the adverts of a category with search_after, sorted by id
+func (repository *AdvertElasticRepository) GetByCategoryId(ctx context.Context, categoryId int64, cursor string, size int, fields ...string) (*model_repository.AdvertCursorResult, error) {
+	query := elastic.EsObject{
+		"size":    size,
+		"_source": sourceFilter(fields),
+		"query": elastic.EsObject{
+			"bool": elastic.EsObject{
+				"filter": elastic.EsArray{
+					elastic.EsObject{"term": elastic.EsObject{"category.id": categoryId}},
+				},
+			},
+		},
+		"sort": elastic.EsArray{
+			elastic.EsObject{"id": "asc"},
+		},
+	}
+	if cursor != "" {
+		searchAfter, err := elastic.DecodeSearchAfter(cursor)
+		if err != nil {
+			return nil, err
+		}
+		query["search_after"] = searchAfter
+	}
+	searchResponse, err := repository.BaseGenericRepository.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	result := &model_repository.AdvertCursorResult{
+		Adverts: make([]*model_repository.Advert, 0, len(searchResponse.Hits.Hits)),
+	}
+	for _, searchHit := range searchResponse.Hits.Hits {
+		_, advert, err := mapToEventForAdvert(searchHit)
+		if err != nil {
+			return nil, err
+		}
+		result.Adverts = append(result.Adverts, advert)
+	}
+	hitCount := len(searchResponse.Hits.Hits)
+	if hitCount == size {
+		nextCursor, err := elastic.EncodeSearchAfter(searchResponse.Hits.Hits[hitCount-1].Sort)
+		if err != nil {
+			return nil, err
+		}
+		result.NextCursor = nextCursor
+	}
+	return result, nil
+}
+
+// GetSimilar finds adverts whose title and description look like the given advert, adverts of the same category are boosted.
+// The adverts are in the order of the hits, most similar first
+func (repository *AdvertElasticRepository) GetSimilar(ctx context.Context, advert *model_repository.Advert, size int) ([]*model_repository.Advert, error) {
+	documentId := fmt.Sprint(advert.Id)
+	query := elastic.EsObject{
+		"size": size,
+		"query": elastic.EsObject{
+			"bool": elastic.EsObject{
+				"must": elastic.EsArray{
+					elastic.EsObject{
+						"more_like_this": elastic.EsObject{
+							"fields":        elastic.EsArray{"title", "description"},
+							"like":          elastic.EsArray{elastic.EsObject{"_id": documentId}},
+							"min_term_freq": 1,
+							"min_doc_freq":  1,
+						},
+					},
+				},
+				"should": elastic.EsArray{
+					elastic.EsObject{"term": elastic.EsObject{"category.id": elastic.EsObject{"value": advert.Category.Id, "boost": similarCategoryBoost}}},
+				},
+				"must_not": elastic.EsArray{
+					elastic.EsObject{"ids": elastic.EsObject{"values": elastic.EsArray{documentId}}},
+				},
+			},
+		},
+	}
+	searchResponse, err := repository.BaseGenericRepository.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	adverts := make([]*model_repository.Advert, 0, len(searchResponse.Hits.Hits))
+	for _, searchHit := range searchResponse.Hits.Hits {
+		_, similarAdvert, err := mapToEventForAdvert(searchHit)
+		if err != nil {
+			return nil, err
+		}
+		adverts = append(adverts, similarAdvert)
+	}
+	return adverts, nil
+}
+
+// GetChanges returns the adverts modified since the given date ordered by lastModifiedDate and id.
+// The checkpoint is the search_after of the last change, it is kept as is when there is no new change.
+// Changes younger than changeSettleWindow are left for a later call, a document indexed late with an older
+// lastModifiedDate would otherwise sort before a checkpoint that has already passed it and never be returned
+func (repository *AdvertElasticRepository) GetChanges(ctx context.Context, since string, checkpoint string, size int) (*model_repository.AdvertChangeResult, error) {
+	settled := &elastic.RangeSearchProperties{
+		Field:        "lastModifiedDate",
+		EndPartition: fmt.Sprintf("now-%ds", int(changeSettleWindow.Seconds())),
+		EndExclusive: true,
+	}
+	if since != "" {
+		settled.StartPartition = since
+	}
+	filter := elastic.EsArray{elastic.RangeQuery(settled)}
+	query := elastic.EsObject{
+		"size": size,
+		"query": elastic.EsObject{
+			"bool": elastic.EsObject{
+				"filter": filter,
+			},
+		},
+		"sort": elastic.EsArray{
+			elastic.EsObject{"lastModifiedDate": "asc"},
+			elastic.EsObject{"id": "asc"},
+		},
+	}
+	if checkpoint != "" {
+		searchAfter, err := elastic.DecodeSearchAfter(checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		query["search_after"] = searchAfter
+	}
+	searchResponse, err := repository.BaseGenericRepository.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	hitCount := len(searchResponse.Hits.Hits)
+	result := &model_repository.AdvertChangeResult{
+		Adverts:    make([]*model_repository.Advert, 0, hitCount),
+		Checkpoint: checkpoint,
+		HasMore:    hitCount == size,
+	}
+	for _, searchHit := range searchResponse.Hits.Hits {
+		_, advert, err := mapToEventForAdvert(searchHit)
+		if err != nil {
+			return nil, err
+		}
+		result.Adverts = append(result.Adverts, advert)
+	}
+	if hitCount > 0 {
+		result.Checkpoint, err = elastic.EncodeSearchAfter(searchResponse.Hits.Hits[hitCount-1].Sort)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// ScrollByCategoryId scrolls all adverts of a category, or all adverts when categoryId is 0, and passes them to consume batch by batch
+func (repository *AdvertElasticRepository) ScrollByCategoryId(ctx context.Context, categoryId int64, consume func(adverts []*model_repository.Advert) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	filter := elastic.EsArray{}
+	if categoryId != 0 {
+		filter = append(filter, elastic.EsObject{"term": elastic.EsObject{"category.id": categoryId}})
+	}
+	query := elastic.EsObject{
+		"query": elastic.EsObject{
+			"bool": elastic.EsObject{
+				"filter": filter,
+			},
+		},
+		"sort": elastic.EsArray{"_doc"},
+	}
+	searchHitsChan, errChan := repository.GetSearchHitsChannel(ctx, query, scrollSize, scrollDuration)
+	for searchHitMap := range searchHitsChan {
+		if len(searchHitMap) == 0 {
+			continue
+		}
+		adverts := make([]*model_repository.Advert, 0, len(searchHitMap))
+		for _, advert := range searchHitMap {
+			adverts = append(adverts, advert)
+		}
+		sort.Slice(adverts, func(i, j int) bool {
+			return adverts[i].Id < adverts[j].Id
+		})
+		if err := consume(adverts); err != nil {
+			// cancelling the context stops the scroll goroutine, it clears the scroll context on exit
+			cancel()
+			return err
+		}
+	}
+	return <-errChan
+}
+
+func (repository *AdvertElasticRepository) SuggestTitles(ctx context.Context, prefix string, size int) ([]*model_repository.Suggestion, error) {
+	options, err := repository.BaseGenericRepository.Suggest(ctx, "title.suggest", prefix, size)
+	if err != nil {
+		return nil, err
+	}
+	suggestions := make([]*model_repository.Suggestion, 0, len(options))
+	for _, option := range options {
+		suggestions = append(suggestions, &model_repository.Suggestion{Text: option.Text, Score: option.Score})
+	}
+	return suggestions, nil
+}
+
+func (repository *AdvertElasticRepository) Search(ctx context.Context, criteria *model_repository.AdvertSearchCriteria) (*model_repository.AdvertSearchResult, error) {
+	query := elastic.EsObject{
+		"from":             criteria.From,
+		"size":             criteria.Size,
+		"track_total_hits": true,
+		"_source":          sourceFilter(criteria.Fields),
+		"query":            advertFilterQuery(&criteria.AdvertFilter),
+	}
+	if len(criteria.Facets) > 0 {
+		query["aggs"] = advertFacetAggregations(criteria.Facets)
+	}
+	// the distance to the origin is returned as a sort value, it is the first sort when sorting by distance
+	// and the tie breaker of the score otherwise
+	distanceSortIndex := -1
+	if near := criteria.Near; near != nil {
+		geoDistanceSort := elastic.EsObject{
+			"_geo_distance": elastic.EsObject{
+				"location": elastic.EsObject{"lat": near.Origin.Lat, "lon": near.Origin.Lon},
+				"order":    "asc",
+				"unit":     "km",
+			},
+		}
+		if criteria.SortByDistance {
+			query["sort"] = elastic.EsArray{geoDistanceSort, "_score"}
+			distanceSortIndex = 0
+		} else {
+			query["sort"] = elastic.EsArray{"_score", geoDistanceSort}
+			distanceSortIndex = 1
+		}
+	}
+	searchResponse, err := repository.BaseGenericRepository.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	result := &model_repository.AdvertSearchResult{
+		Adverts:     make([]*model_repository.Advert, 0, len(searchResponse.Hits.Hits)),
+		Facets:      mapToAdvertFacets(criteria.Facets, searchResponse.Aggregations),
+		DistancesKm: make(map[int64]float64),
+	}
+	if searchResponse.Hits.Total != nil {
+		result.TotalCount = searchResponse.Hits.Total.Value
+	}
+	// hits are mapped in response order to keep the relevance ranking
+	for _, searchHit := range searchResponse.Hits.Hits {
+		_, advert, err := mapToEventForAdvert(searchHit)
+		if err != nil {
+			return nil, err
+		}
+		result.Adverts = append(result.Adverts, advert)
+		if distanceSortIndex >= 0 && distanceSortIndex < len(searchHit.Sort) {
+			var distance float64
+			// adverts without location have no distance, elastic returns "Infinity" for them
+			if err := custom_json.Unmarshal(searchHit.Sort[distanceSortIndex], &distance); err == nil {
+				result.DistancesKm[advert.Id] = distance
+			}
+		}
+	}
+	return result, nil
+}
+
+// Count uses the count api when exact is true, otherwise total hits are tracked up to countTrackLimit which is cheaper for large results
+func (repository *AdvertElasticRepository) Count(ctx context.Context, filter *model_repository.AdvertFilter, exact bool) (*model_repository.AdvertCount, error) {
+	if exact {
+		countResponse, err := repository.BaseGenericRepository.GetCount(ctx, elastic.EsObject{"query": advertFilterQuery(filter)})
+		if err != nil {
+			return nil, err
+		}
+		return &model_repository.AdvertCount{Count: countResponse.Count, Relation: model_repository.CountRelationExact}, nil
+	}
+	searchResponse, err := repository.BaseGenericRepository.Search(ctx, elastic.EsObject{
+		"size":             0,
+		"track_total_hits": countTrackLimit,
+		"query":            advertFilterQuery(filter),
+	})
+	if err != nil {
+		return nil, err
+	}
+	count := &model_repository.AdvertCount{Relation: model_repository.CountRelationExact}
+	if searchResponse.Hits.Total != nil {
+		count.Count = searchResponse.Hits.Total.Value
+		if searchResponse.Hits.Total.Relation == "gte" {
+			count.Relation = model_repository.CountRelationLowerBound
+		}
+	}
+	return count, nil
+}
+
+// GetByIds returns the found adverts in the order of ids together with the ids that were not found
+func (repository *AdvertElasticRepository) GetByIds(ctx context.Context, ids []int64, fields ...string) ([]*model_repository.Advert, []int64, error) {
+	documentIds := make([]string, 0, len(ids))
+	for _, id := range ids {
+		documentIds = append(documentIds, fmt.Sprint(id))
+	}
+	documents, err := repository.BaseGenericRepository.GetByIds(ctx, documentIds, sourceIncludes(fields)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	adverts := make([]*model_repository.Advert, 0, len(documents))
+	missingIds := make([]int64, 0)
+	for i, document := range documents {
+		if document == nil || document.Deleted {
+			missingIds = append(missingIds, ids[i])
+			continue
+		}
+		adverts = append(adverts, document)
+	}
+	return adverts, missingIds, nil
+}
+
+// deletedQuery matches tombstones. Only the change feed returns them and the id reads answer not found for them,
+// like the cache does for the tombstones of the feed. Searches and lists do not filter them, the writer does
+// not index tombstones yet.
+var deletedQuery = elastic.EsObject{"term": elastic.EsObject{"deleted": true}}
+
+const (
+	countTrackLimit        = 10000
+	scrollSize             = 1000
+	scrollDuration         = time.Minute
+	categoryFacetSize      = 20
+	categoryNameTopHitsKey = "category_name"
+	similarCategoryBoost   = 2.0
+	// changeSettleWindow covers the index refresh interval and the delay between setting lastModifiedDate and indexing
+	changeSettleWindow = 30 * time.Second
+)
+
+func advertFilterQuery(advertFilter *model_repository.AdvertFilter) elastic.EsObject {
+	must := elastic.EsArray{}
+	if advertFilter.Text != "" {
+		must = append(must, elastic.EsObject{
+			"multi_match": elastic.EsObject{
+				"query":  advertFilter.Text,
+				"fields": []string{"title", "description"},
+			},
+		})
+	} else {
+		must = append(must, elastic.EsObject{"match_all": elastic.EsObject{}})
+	}
+	filter := elastic.EsArray{}
+	if advertFilter.CategoryId != 0 {
+		filter = append(filter, elastic.EsObject{
+			"term": elastic.EsObject{"category.id": advertFilter.CategoryId},
+		})
+	}
+	ranges := make([]model_repository.RangeFilter, 0, len(advertFilter.Ranges)+2)
+	if advertFilter.CreationDateFrom != "" {
+		ranges = append(ranges, model_repository.RangeFilter{Field: "creationDate", Operator: model_repository.RangeOperatorGte, Value: advertFilter.CreationDateFrom})
+	}
+	if advertFilter.CreationDateTo != "" {
+		ranges = append(ranges, model_repository.RangeFilter{Field: "creationDate", Operator: model_repository.RangeOperatorLte, Value: advertFilter.CreationDateTo})
+	}
+	filter = append(filter, rangeFilterQueries(append(ranges, advertFilter.Ranges...))...)
+	if near := advertFilter.Near; near != nil && near.RadiusKm > 0 {
+		filter = append(filter, elastic.EsObject{
+			"geo_distance": elastic.EsObject{
+				"distance": fmt.Sprintf("%gkm", near.RadiusKm),
+				"location": elastic.EsObject{"lat": near.Origin.Lat, "lon": near.Origin.Lon},
+			},
+		})
+	}
+	return elastic.EsObject{
+		"bool": elastic.EsObject{
+			"must":   must,
+			"filter": filter,
+		},
+	}
+}
+
+func advertFacetAggregations(facets []model_repository.AdvertFacet) elastic.EsObject {
+	aggregations := elastic.EsObject{}
+	for _, facet := range facets {
+		switch facet {
+		case model_repository.AdvertFacetCategory:
+			aggregations[string(facet)] = elastic.EsObject{
+				"terms": elastic.EsObject{
+					"field": "category.id",
+					"size":  categoryFacetSize,
+				},
+				"aggs": elastic.EsObject{
+					categoryNameTopHitsKey: elastic.EsObject{
+						"top_hits": elastic.EsObject{
+							"size":    1,
+							"_source": elastic.EsObject{"includes": []string{"category"}},
+						},
+					},
+				},
+			}
+		case model_repository.AdvertFacetCreationMonth:
+			aggregations[string(facet)] = elastic.EsObject{
+				"date_histogram": elastic.EsObject{
+					"field":             "creationDate",
+					"calendar_interval": "month",
+					"format":            "yyyy-MM",
+					"min_doc_count":     1,
+				},
+			}
+		}
+	}
+	return aggregations
+}
+
+func mapToAdvertFacets(facets []model_repository.AdvertFacet, aggregations elastic.AggregateDictionary) map[model_repository.AdvertFacet][]model_repository.FacetBucket {
+	if len(facets) == 0 {
+		return nil
+	}
+	result := make(map[model_repository.AdvertFacet][]model_repository.FacetBucket, len(facets))
+	for _, facet := range facets {
+		buckets := make([]model_repository.FacetBucket, 0)
+		if termsAggregate, found := aggregations.Terms(string(facet)); found {
+			for _, bucket := range termsAggregate.Buckets {
+				facetBucket := model_repository.FacetBucket{
+					Key:   fmt.Sprint(bucket.Key),
+					Count: bucket.DocCount,
+				}
+				if bucket.KeyAsString != "" {
+					facetBucket.Key = bucket.KeyAsString
+				}
+				if facet == model_repository.AdvertFacetCategory {
+					facetBucket.Key = fmt.Sprintf("%.0f", bucket.Key)
+					category := categoryOfBucket(bucket)
+					facetBucket.Name, facetBucket.Names = category.Name, category.Names
+				}
+				buckets = append(buckets, facetBucket)
+			}
+		}
+		result[facet] = buckets
+	}
+	return result
+}
+
+func categoryOfBucket(bucket elastic.TermsAggregateBucket) model_repository.AdvertCategory {
+	topHits, found := bucket.AggregateDictionary.TopHits(categoryNameTopHitsKey)
+	if !found || topHits.Hits == nil || topHits.Hits.Hits == nil || len(topHits.Hits.Hits.Hits) == 0 {
+		return model_repository.AdvertCategory{}
+	}
+	_, advert, err := mapToEventForAdvert(topHits.Hits.Hits.Hits[0])
+	if err != nil {
+		return model_repository.AdvertCategory{}
+	}
+	return advert.Category
 }
 
 func mapToIdForAdvert(searchHit *elastic.SearchHit) (string, error) {
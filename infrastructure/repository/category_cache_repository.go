@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	appRepository "presentation-advert-read-api/application/repository"
+	"presentation-advert-read-api/infrastructure/configuration/cache"
+	"presentation-advert-read-api/model/model_repository"
+)
+
+// CategoryCacheRepository reads categories by id through the cache of whole documents, every other call goes
+// to the wrapped repository and is observed, so older cached categories are not returned
+type CategoryCacheRepository struct {
+	appRepository.CategoryRepository
+	cache *documentCache[model_repository.Category]
+}
+
+// NewCategoryCacheRepository uses only the in-process cache when backend is nil
+func NewCategoryCacheRepository(categoryRepository appRepository.CategoryRepository, config *cache.Config, backend cache.Backend) *CategoryCacheRepository {
+	return &CategoryCacheRepository{
+		CategoryRepository: categoryRepository,
+		cache: newDocumentCache("categories", config, backend,
+			func(category *model_repository.Category) int64 { return category.Id },
+			func(category *model_repository.Category) int16 { return category.Version }),
+	}
+}
+
+func (repository *CategoryCacheRepository) Save(ctx context.Context, model *model_repository.Category) error {
+	if err := repository.CategoryRepository.Save(ctx, model); err != nil {
+		return err
+	}
+	repository.cache.remove(ctx, model.Id)
+	return nil
+}
+
+func (repository *CategoryCacheRepository) GetById(ctx context.Context, id int64, fields ...string) (*model_repository.Category, error) {
+	return repository.cache.getById(ctx, id, fields, repository.CategoryRepository.GetById)
+}
+
+func (repository *CategoryCacheRepository) ExistsById(ctx context.Context, id int64) (bool, error) {
+	return repository.cache.existsById(ctx, id, repository.CategoryRepository.ExistsById)
+}
+
+func (repository *CategoryCacheRepository) GetByIds(ctx context.Context, ids []int64, fields ...string) ([]*model_repository.Category, []int64, error) {
+	return repository.cache.getByIds(ctx, ids, fields, repository.CategoryRepository.GetByIds)
+}
+
+func (repository *CategoryCacheRepository) GetAncestors(ctx context.Context, id int64) ([]*model_repository.Category, error) {
+	categories, err := repository.CategoryRepository.GetAncestors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	repository.observe(categories)
+	return categories, nil
+}
+
+func (repository *CategoryCacheRepository) GetSubtree(ctx context.Context, id int64) ([]*model_repository.Category, error) {
+	categories, err := repository.CategoryRepository.GetSubtree(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	repository.observe(categories)
+	return categories, nil
+}
+
+func (repository *CategoryCacheRepository) Search(ctx context.Context, criteria *model_repository.CategorySearchCriteria) (*model_repository.CategorySearchResult, error) {
+	result, err := repository.CategoryRepository.Search(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+	repository.observe(result.Categories)
+	return result, nil
+}
+
+func (repository *CategoryCacheRepository) observe(categories []*model_repository.Category) {
+	for _, category := range categories {
+		repository.cache.observe(category)
+	}
+}
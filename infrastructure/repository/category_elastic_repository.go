@@ -10,14 +10,18 @@ import (
 	"presentation-advert-read-api/model/model_repository"
 )
 
+const maxCategoryTreeSize = 10000
+
 type CategoryElasticRepository struct {
 	elastic.BaseGenericRepository[string, model_repository.Category]
+	indexName string
 }
 
 func NewCategoryElasticRepository(elasticClientMap elasticv7.ClusterClientMap, clusterName string, indexName string) (*CategoryElasticRepository, error) {
 	if client, exists := elasticClientMap[clusterName]; exists {
 		return &CategoryElasticRepository{
 			BaseGenericRepository: elasticv7.NewBaseGenericRepository(client, indexName, mapToEventForCategory, mapToIdForCategory),
+			indexName:             indexName,
 		}, nil
 	}
 	return nil, custom_error.NewConfigNotFoundErr("elastic client not found")
@@ -28,8 +32,175 @@ func (repository *CategoryElasticRepository) Save(ctx context.Context, model *mo
 	return repository.IndexDocument(ctx, &elastic.IndexDocument{Id: id, Routing: id, Body: model})
 }
 
-func (repository *CategoryElasticRepository) GetById(ctx context.Context, id int64) (*model_repository.Category, error) {
-	return repository.BaseGenericRepository.GetById(ctx, fmt.Sprint(id), "")
+func (repository *CategoryElasticRepository) GetById(ctx context.Context, id int64, fields ...string) (*model_repository.Category, error) {
+	return repository.BaseGenericRepository.GetById(ctx, fmt.Sprint(id), "", sourceIncludes(fields)...)
+}
+
+// ExistsById checks the document without fetching its _source
+func (repository *CategoryElasticRepository) ExistsById(ctx context.Context, id int64) (bool, error) {
+	documentId := fmt.Sprint(id)
+	return repository.BaseGenericRepository.ExistsById(ctx, &elastic.ExistsDocument{Id: documentId, Routing: documentId})
+}
+
+// GetByIds returns the found categories in the order of ids together with the ids that were not found
+func (repository *CategoryElasticRepository) GetByIds(ctx context.Context, ids []int64, fields ...string) ([]*model_repository.Category, []int64, error) {
+	documentIds := make([]string, 0, len(ids))
+	for _, id := range ids {
+		documentIds = append(documentIds, fmt.Sprint(id))
+	}
+	documents, err := repository.BaseGenericRepository.GetByIds(ctx, documentIds, sourceIncludes(fields)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	categories := make([]*model_repository.Category, 0, len(documents))
+	missingIds := make([]int64, 0)
+	for i, document := range documents {
+		if document == nil {
+			missingIds = append(missingIds, ids[i])
+			continue
+		}
+		categories = append(categories, document)
+	}
+	return categories, missingIds, nil
+}
+
+// GetAncestors returns the category and its ancestors ordered from the root, the path is resolved by elastic with a terms lookup
+func (repository *CategoryElasticRepository) GetAncestors(ctx context.Context, id int64) ([]*model_repository.Category, error) {
+	documentId := fmt.Sprint(id)
+	query := elastic.EsObject{
+		"size": maxCategoryTreeSize,
+		"query": elastic.EsObject{
+			"bool": elastic.EsObject{
+				"should": elastic.EsArray{
+					elastic.EsObject{"term": elastic.EsObject{"id": id}},
+					elastic.EsObject{"terms": elastic.EsObject{
+						"id": elastic.EsObject{
+							"index":   repository.indexName,
+							"id":      documentId,
+							"routing": documentId,
+							"path":    "path",
+						},
+					}},
+				},
+				"minimum_should_match": 1,
+			},
+		},
+		"sort": elastic.EsArray{
+			elastic.EsObject{"depth": "asc"},
+		},
+	}
+	return repository.searchCategoryChain(ctx, query, id)
+}
+
+// GetSubtree returns the category and all of its descendants ordered by depth
+func (repository *CategoryElasticRepository) GetSubtree(ctx context.Context, id int64) ([]*model_repository.Category, error) {
+	query := elastic.EsObject{
+		"size": maxCategoryTreeSize,
+		"query": elastic.EsObject{
+			"bool": elastic.EsObject{
+				"should": elastic.EsArray{
+					elastic.EsObject{"term": elastic.EsObject{"id": id}},
+					elastic.EsObject{"term": elastic.EsObject{"path": id}},
+				},
+				"minimum_should_match": 1,
+			},
+		},
+		"sort": elastic.EsArray{
+			elastic.EsObject{"depth": "asc"},
+			elastic.EsObject{"id": "asc"},
+		},
+	}
+	return repository.searchCategoryChain(ctx, query, id)
+}
+
+func (repository *CategoryElasticRepository) searchCategoryChain(ctx context.Context, query elastic.EsObject, id int64) ([]*model_repository.Category, error) {
+	searchResponse, err := repository.BaseGenericRepository.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	categories := make([]*model_repository.Category, 0, len(searchResponse.Hits.Hits))
+	found := false
+	for _, searchHit := range searchResponse.Hits.Hits {
+		_, category, err := mapToEventForCategory(searchHit)
+		if err != nil {
+			return nil, err
+		}
+		if category.Id == id {
+			found = true
+		}
+		categories = append(categories, category)
+	}
+	if !found {
+		return nil, custom_error.NotFoundErrWithArgs("Category not found by id %d", id)
+	}
+	return categories, nil
+}
+
+func (repository *CategoryElasticRepository) SuggestNames(ctx context.Context, prefix string, size int) ([]*model_repository.Suggestion, error) {
+	options, err := repository.BaseGenericRepository.Suggest(ctx, "name.suggest", prefix, size)
+	if err != nil {
+		return nil, err
+	}
+	suggestions := make([]*model_repository.Suggestion, 0, len(options))
+	for _, option := range options {
+		suggestions = append(suggestions, &model_repository.Suggestion{Text: option.Text, Score: option.Score})
+	}
+	return suggestions, nil
+}
+
+func (repository *CategoryElasticRepository) Search(ctx context.Context, criteria *model_repository.CategorySearchCriteria) (*model_repository.CategorySearchResult, error) {
+	filter := elastic.EsArray{}
+	if criteria.NamePrefix != "" {
+		filter = append(filter, elastic.EsObject{
+			"prefix": elastic.EsObject{
+				"name.keyword": elastic.EsObject{
+					"value":            criteria.NamePrefix,
+					"case_insensitive": true,
+				},
+			},
+		})
+	}
+	filter = append(filter, rangeFilterQueries(criteria.Ranges)...)
+	order := "asc"
+	if criteria.Descending {
+		order = "desc"
+	}
+	sort := elastic.EsArray{}
+	if criteria.SortField == model_repository.CategorySortByName {
+		sort = append(sort, elastic.EsObject{"name.keyword": order})
+	}
+	// id is unique, so it keeps the order stable when names are equal
+	sort = append(sort, elastic.EsObject{"id": order})
+	query := elastic.EsObject{
+		"from":             criteria.From,
+		"size":             criteria.Size,
+		"track_total_hits": true,
+		"_source":          sourceFilter(criteria.Fields),
+		"query": elastic.EsObject{
+			"bool": elastic.EsObject{
+				"filter": filter,
+			},
+		},
+		"sort": sort,
+	}
+	searchResponse, err := repository.BaseGenericRepository.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	result := &model_repository.CategorySearchResult{
+		Categories: make([]*model_repository.Category, 0, len(searchResponse.Hits.Hits)),
+	}
+	if searchResponse.Hits.Total != nil {
+		result.TotalCount = searchResponse.Hits.Total.Value
+	}
+	for _, searchHit := range searchResponse.Hits.Hits {
+		_, category, err := mapToEventForCategory(searchHit)
+		if err != nil {
+			return nil, err
+		}
+		result.Categories = append(result.Categories, category)
+	}
+	return result, nil
 }
 
 func mapToIdForCategory(searchHit *elastic.SearchHit) (string, error) {
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"context"
+	"presentation-advert-read-api/infrastructure/configuration/cache"
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+)
+
+// documentCache holds documents by id in process and then in the shared cache of the backend for the cache
+// repositories. Only whole documents are cached, a read with fields is answered from the cache when the document
+// is cached and otherwise fetched with its fields. Cached documents are shared and must not be modified.
+type documentCache[T any] struct {
+	local  *cache.LRU[int64, *T]
+	shared *cache.Shared[int64, T]
+	// seenVersions holds the newest version returned by the other reads, an older shared entry is not used
+	seenVersions *cache.LRU[int64, int16]
+	idOf         func(document *T) int64
+	versionOf    func(document *T) int16
+}
+
+// newDocumentCache uses only the in-process cache when backend is nil
+func newDocumentCache[T any](name string, config *cache.Config, backend cache.Backend, idOf func(*T) int64, versionOf func(*T) int16) *documentCache[T] {
+	return &documentCache[T]{
+		local:        cache.NewLRU[int64, *T](name, config),
+		shared:       cache.NewShared[int64, T](name, backend, config),
+		seenVersions: cache.NewLRU[int64, int16](name+"_seen_versions", &cache.Config{Size: config.Size, Ttl: max(config.Ttl, config.SharedTtl)}),
+		idOf:         idOf,
+		versionOf:    versionOf,
+	}
+}
+
+// getById returns the cached document of id or fetches it, only a whole document is cached
+func (documents *documentCache[T]) getById(ctx context.Context, id int64, fields []string, fetch func(ctx context.Context, id int64, fields ...string) (*T, error)) (*T, error) {
+	if document, found, cached := documents.get(ctx, id); cached {
+		if !found {
+			return nil, custom_error.NotFoundErrWithArgs("GetById, Document not found by id %d", id)
+		}
+		return document, nil
+	}
+	document, err := fetch(ctx, id, fields...)
+	if err != nil {
+		if custom_error.IsNotFoundError(err) {
+			documents.addNotFound(ctx, id)
+		}
+		return nil, err
+	}
+	documents.addFetched(ctx, document, fields)
+	return document, nil
+}
+
+// existsById answers from the in-process cache and otherwise asks exists without fetching the document
+func (documents *documentCache[T]) existsById(ctx context.Context, id int64, exists func(ctx context.Context, id int64) (bool, error)) (bool, error) {
+	if _, found, cached := documents.local.Get(id); cached {
+		return found, nil
+	}
+	return exists(ctx, id)
+}
+
+// getByIds fetches only the ids that are not cached, the found documents keep the order of ids
+func (documents *documentCache[T]) getByIds(ctx context.Context, ids []int64, fields []string, fetch func(ctx context.Context, ids []int64, fields ...string) ([]*T, []int64, error)) ([]*T, []int64, error) {
+	cachedDocuments, notFoundIds, uncachedIds := documents.getMany(ctx, ids)
+	if len(uncachedIds) > 0 {
+		fetchedDocuments, missingIds, err := fetch(ctx, uncachedIds, fields...)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, document := range fetchedDocuments {
+			documents.addFetched(ctx, document, fields)
+			cachedDocuments[documents.idOf(document)] = document
+		}
+		for _, id := range missingIds {
+			documents.addNotFound(ctx, id)
+			notFoundIds[id] = true
+		}
+	}
+	found := make([]*T, 0, len(ids))
+	missingIds := make([]int64, 0)
+	for _, id := range ids {
+		if document, exists := cachedDocuments[id]; exists {
+			found = append(found, document)
+		} else if notFoundIds[id] {
+			missingIds = append(missingIds, id)
+		}
+	}
+	return found, missingIds, nil
+}
+
+// get looks id up in process and then in the shared cache, cached is false when neither has a current entry
+func (documents *documentCache[T]) get(ctx context.Context, id int64) (document *T, found bool, cached bool) {
+	if document, found, cached = documents.local.Get(id); cached {
+		return document, found, true
+	}
+	if document, found, cached = documents.shared.Get(ctx, id); cached && documents.isCurrent(id, document, found) {
+		if !found {
+			documents.local.AddNotFound(id)
+			return nil, false, true
+		}
+		documents.addLocal(document)
+		return document, true, true
+	}
+	return nil, false, false
+}
+
+// getMany looks ids up in process and then in the shared cache and returns the ids without a current entry
+func (documents *documentCache[T]) getMany(ctx context.Context, ids []int64) (cachedDocuments map[int64]*T, notFoundIds map[int64]bool, uncachedIds []int64) {
+	cachedDocuments = make(map[int64]*T, len(ids))
+	notFoundIds = make(map[int64]bool)
+	uncachedIds = make([]int64, 0, len(ids))
+	for _, id := range ids {
+		document, found, cached := documents.local.Get(id)
+		switch {
+		case !cached:
+			uncachedIds = append(uncachedIds, id)
+		case found:
+			cachedDocuments[id] = document
+		default:
+			notFoundIds[id] = true
+		}
+	}
+	sharedDocuments, sharedNotFoundIds, uncachedIds := documents.shared.GetMany(ctx, uncachedIds)
+	for id, document := range sharedDocuments {
+		if !documents.isCurrent(id, document, true) {
+			uncachedIds = append(uncachedIds, id)
+			continue
+		}
+		documents.addLocal(document)
+		cachedDocuments[id] = document
+	}
+	for _, id := range sharedNotFoundIds {
+		if !documents.isCurrent(id, nil, false) {
+			uncachedIds = append(uncachedIds, id)
+			continue
+		}
+		documents.local.AddNotFound(id)
+		notFoundIds[id] = true
+	}
+	return cachedDocuments, notFoundIds, uncachedIds
+}
+
+// addFetched caches a whole document, a document fetched with fields is partial and only observed
+func (documents *documentCache[T]) addFetched(ctx context.Context, document *T, fields []string) {
+	if len(fields) > 0 {
+		documents.observe(document)
+		return
+	}
+	documents.addLocal(document)
+	documents.shared.Add(ctx, documents.idOf(document), document)
+}
+
+func (documents *documentCache[T]) addNotFound(ctx context.Context, id int64) {
+	documents.local.AddNotFound(id)
+	documents.shared.AddNotFound(ctx, id)
+}
+
+// addDeleted caches a deleted document as not found and forgets its seen version
+func (documents *documentCache[T]) addDeleted(ctx context.Context, id int64) {
+	documents.addNotFound(ctx, id)
+	documents.seenVersions.Remove(id, cache.EvictionReasonVersion)
+}
+
+func (documents *documentCache[T]) remove(ctx context.Context, id int64) {
+	documents.local.Remove(id, cache.EvictionReasonVersion)
+	documents.shared.Remove(ctx, id)
+}
+
+// addLocal caches the document in process unless a newer version is already cached
+func (documents *documentCache[T]) addLocal(document *T) {
+	version := documents.versionOf(document)
+	documents.local.ReplaceIf(documents.idOf(document), document, func(cached *T, found bool) bool {
+		return found && documents.versionOf(cached) > version
+	})
+}
+
+// isCurrent reports whether a shared entry is not older than the version seen by the other reads,
+// a not found is outdated once the document has been seen
+func (documents *documentCache[T]) isCurrent(id int64, document *T, found bool) bool {
+	seenVersion, seen := documents.seenVersions.Peek(id)
+	return !seen || (found && documents.versionOf(document) >= seenVersion)
+}
+
+// observe removes the cached document that is older than a document returned by another read and remembers
+// its version for the shared entries, the reads may be partial documents, so they are not cached themselves
+func (documents *documentCache[T]) observe(document *T) {
+	id, version := documents.idOf(document), documents.versionOf(document)
+	documents.seenVersions.ReplaceIf(id, version, func(seenVersion int16, _ bool) bool {
+		return seenVersion >= version
+	})
+	documents.local.RemoveIf(id, cache.EvictionReasonVersion, func(cached *T, found bool) bool {
+		return !found || documents.versionOf(cached) < version
+	})
+}
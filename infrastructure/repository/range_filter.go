@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"presentation-advert-read-api/infrastructure/configuration/elastic"
+	"presentation-advert-read-api/model/model_repository"
+)
+
+// rangeFilterQueries returns a range clause per filter in the order of filters. The clauses are ANDed in the
+// filter context, so bounds on the same field narrow the range and never replace one another.
+func rangeFilterQueries(filters []model_repository.RangeFilter) elastic.EsArray {
+	queries := make(elastic.EsArray, 0, len(filters))
+	for _, filter := range filters {
+		properties := &elastic.RangeSearchProperties{Field: filter.Field}
+		switch filter.Operator {
+		case model_repository.RangeOperatorGte, model_repository.RangeOperatorGt:
+			properties.StartPartition = filter.Value
+			properties.StartExclusive = filter.Operator == model_repository.RangeOperatorGt
+		case model_repository.RangeOperatorLte, model_repository.RangeOperatorLt:
+			properties.EndPartition = filter.Value
+			properties.EndExclusive = filter.Operator == model_repository.RangeOperatorLt
+		default:
+			continue
+		}
+		queries = append(queries, elastic.RangeQuery(properties))
+	}
+	return queries
+}
@@ -0,0 +1,32 @@
+package repository
+
+// identityFields are always fetched, responses are mapped by id, conditional requests need the version
+// and tombstones are recognized by deleted
+var identityFields = []string{"id", "version", "lastModifiedDate", "deleted"}
+
+// localizedFields are fetched with the names the response name is resolved from
+var localizedFields = map[string]string{"name": "names", "category.name": "category.names"}
+
+// sourceIncludes returns the _source fields to fetch, nil means the whole document
+func sourceIncludes(fields []string) []string {
+	if len(fields) == 0 {
+		return nil
+	}
+	includes := make([]string, 0, len(identityFields)+len(fields))
+	includes = append(includes, identityFields...)
+	for _, field := range fields {
+		includes = append(includes, field)
+		if localizedField, exists := localizedFields[field]; exists {
+			includes = append(includes, localizedField)
+		}
+	}
+	return includes
+}
+
+// sourceFilter returns the _source value of a search body
+func sourceFilter(fields []string) interface{} {
+	if includes := sourceIncludes(fields); includes != nil {
+		return includes
+	}
+	return true
+}
@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"time"
+)
+
+// DefaultPageSize and MaxPageSize apply to every paged endpoint of the HTTP and gRPC APIs
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+	// MaxResultWindow is the index.max_result_window of elastic, deeper pages fail in the search
+	MaxResultWindow = 10000
+)
+
+func Page(page int) error {
+	if page < 0 {
+		return custom_error.BadRequestErr("page must not be negative")
+	}
+	return nil
+}
+
+func PageSize(size int) error {
+	if size < 1 || size > MaxPageSize {
+		return custom_error.BadRequestErrWithArgs("size must be between 1 and %d", MaxPageSize)
+	}
+	return nil
+}
+
+// ResultWindow checks that the page ends within MaxResultWindow, size must be positive
+func ResultWindow(page int, size int) error {
+	// (page+1)*size could overflow for a large page
+	if page > MaxResultWindow/size-1 {
+		return custom_error.BadRequestErrWithArgs("(page+1)*size must be at most %d", MaxResultWindow)
+	}
+	return nil
+}
+
+// Date accepts an empty value, a yyyy-MM-dd date or an RFC 3339 timestamp
+func Date(name string, value string) error {
+	if value == "" || IsDate(value) {
+		return nil
+	}
+	return custom_error.BadRequestErrWithArgs("%s must be a yyyy-MM-dd date or an RFC 3339 timestamp", name)
+}
+
+func IsDate(value string) bool {
+	if _, err := time.Parse(time.DateOnly, value); err == nil {
+		return true
+	}
+	_, err := time.Parse(time.RFC3339, value)
+	return err == nil
+}
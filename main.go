@@ -3,15 +3,21 @@ package main
 import (
 	"github.com/labstack/echo/v4"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net"
 	"os"
 	"os/signal"
 	_ "presentation-advert-read-api/docs"
+	"presentation-advert-read-api/infrastructure/configuration/cache"
 	"presentation-advert-read-api/infrastructure/configuration/configreader"
 	"presentation-advert-read-api/infrastructure/configuration/custom_error"
+	"presentation-advert-read-api/infrastructure/configuration/custom_json"
 	"presentation-advert-read-api/infrastructure/configuration/elastic/elasticv7"
+	"presentation-advert-read-api/infrastructure/configuration/locale"
 	"presentation-advert-read-api/infrastructure/configuration/log"
 	"presentation-advert-read-api/infrastructure/configuration/server"
 	"presentation-advert-read-api/infrastructure/controller"
+	"presentation-advert-read-api/infrastructure/graphql_api"
+	"presentation-advert-read-api/infrastructure/grpc_api"
 	"presentation-advert-read-api/infrastructure/handlers"
 	"presentation-advert-read-api/infrastructure/repository"
 	"strings"
@@ -20,10 +26,14 @@ import (
 
 func main() {
 	e := echo.New()
+	e.JSONSerializer = custom_json.EchoSerializer{}
 
 	logConfig := configreader.ReadLogConfig("log-config")
 	serverConfig := configreader.ReadServerConf("server-config")
 	elasticConfigMap := configreader.ReadElasticConfig("elastic-config")
+	localeConfig := configreader.ReadLocaleConfig("locale-config")
+	cacheConfigMap := configreader.ReadCacheConfig("cache-config")
+	cacheBackendConfig := configreader.ReadCacheBackendConfig("cache-backend-config")
 
 	logger := log.NewLogger(logConfig.Level)
 	e.Logger = logger
@@ -43,15 +53,40 @@ func main() {
 		e.Logger.Fatal(err)
 	}
 
-	queryHandler, err := handlers.InitializeQueryHandler(categoryElasticRepository, advertElasticRepository)
+	// Cache
+	cacheBackend, err := cache.NewBackend(cacheBackendConfig)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	categoryCacheConfig, err := cacheConfigMap.GetConfig("categories")
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	advertCacheConfig, err := cacheConfigMap.GetConfig("adverts")
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	categoryCacheRepository := repository.NewCategoryCacheRepository(categoryElasticRepository, categoryCacheConfig, cacheBackend)
+	advertCacheRepository := repository.NewAdvertCacheRepository(advertElasticRepository, advertCacheConfig, cacheBackend)
+
+	queryHandler, err := handlers.InitializeQueryHandler(categoryCacheRepository, advertCacheRepository)
 	if err != nil {
 		e.Logger.Fatal(err)
 	}
 
 	controller.NewAdvertController(e, queryHandler)
 	controller.NewCategoryController(e, queryHandler)
+	controller.NewSuggestController(e, queryHandler)
+
+	graphqlExecutor, err := graphql_api.NewExecutor(queryHandler)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	controller.NewGraphqlController(e, graphqlExecutor)
 
 	//Middleware
+	e.Use(server.CompressionMiddleware(serverConfig.CompressionMinSize))
+	e.Use(locale.Middleware(localeConfig))
 	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
 
 	//HealthCheck
@@ -70,6 +105,17 @@ func main() {
 			}
 		}
 	}()
+
+	grpcServer := grpc_api.NewServer(queryHandler, localeConfig)
+	go func() {
+		listener, err := net.Listen("tcp", serverConfig.GrpcPort)
+		if err != nil {
+			e.Logger.Fatal(err)
+		}
+		if err := grpcServer.Serve(listener); err != nil {
+			e.Logger.Fatal(err)
+		}
+	}()
 	serverChannel := make(chan struct{})
 
 	// Stop Server
@@ -83,4 +129,5 @@ func main() {
 		close(serverChannel)
 	}()
 	<-serverChannel
+	grpcServer.GracefulStop()
 }
@@ -0,0 +1,18 @@
+package model_api
+
+type AdvertChangesResponse struct {
+	Changes []AdvertChangeResponse `json:"changes"`
+	// Checkpoint is passed as checkpoint to continue the feed, it is returned even when there is no change
+	Checkpoint string `json:"checkpoint"`
+	HasMore    bool   `json:"hasMore"`
+}
+
+// AdvertChangeResponse is the last state of a changed advert, Advert is empty when it is deleted and
+// reading a deleted advert by id answers not found
+type AdvertChangeResponse struct {
+	Id               int64           `json:"id"`
+	Version          int16           `json:"version"`
+	LastModifiedDate string          `json:"lastModifiedDate"`
+	Deleted          bool            `json:"deleted"`
+	Advert           *AdvertResponse `json:"advert,omitempty"`
+}
@@ -0,0 +1,6 @@
+package model_api
+
+type AdvertCountResponse struct {
+	Count    int64  `json:"count"`
+	Relation string `json:"relation"`
+}
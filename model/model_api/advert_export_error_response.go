@@ -0,0 +1,6 @@
+package model_api
+
+// AdvertExportErrorResponse is the last line of an export that failed after its first advert was sent
+type AdvertExportErrorResponse struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,11 @@
+package model_api
+
+// AdvertFullResponse is the full view of a advert, it adds the audit metadata to AdvertResponse
+type AdvertFullResponse struct {
+	AdvertResponse
+	Version          int16  `json:"version"`
+	CreatedBy        string `json:"createdBy"`
+	CreationDate     string `json:"creationDate"`
+	ModifiedBy       string `json:"modifiedBy"`
+	LastModifiedDate string `json:"lastModifiedDate"`
+}
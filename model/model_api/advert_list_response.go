@@ -0,0 +1,6 @@
+package model_api
+
+type AdvertListResponse struct {
+	Adverts    []AdvertResponse `json:"adverts"`
+	MissingIds []int64          `json:"missingIds"`
+}
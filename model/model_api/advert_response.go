@@ -5,9 +5,22 @@ type AdvertResponse struct {
 	Title       string                 `json:"title"`
 	Description string                 `json:"description"`
 	Category    AdvertCategoryResponse `json:"category"`
+	Location    *GeoPointResponse      `json:"location,omitempty"`
+	City        string                 `json:"city"`
+	District    string                 `json:"district"`
+	// Distance is the distance in kilometres to the search origin
+	Distance *float64 `json:"distance,omitempty"`
+	// Version and LastModifiedDate are not serialized, they are used for conditional requests
+	Version          int16  `json:"-"`
+	LastModifiedDate string `json:"-"`
 }
 
 type AdvertCategoryResponse struct {
 	Id   int64  `json:"id"`
 	Name string `json:"name"`
 }
+
+type GeoPointResponse struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
@@ -0,0 +1,15 @@
+package model_api
+
+type AdvertSearchResponse struct {
+	Adverts    []AdvertResponse                 `json:"adverts"`
+	Facets     map[string][]FacetBucketResponse `json:"facets,omitempty"`
+	Page       int                              `json:"page"`
+	Size       int                              `json:"size"`
+	TotalCount int64                            `json:"totalCount"`
+}
+
+type FacetBucketResponse struct {
+	Key   string `json:"key"`
+	Name  string `json:"name,omitempty"`
+	Count int64  `json:"count"`
+}
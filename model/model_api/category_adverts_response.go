@@ -0,0 +1,6 @@
+package model_api
+
+type CategoryAdvertsResponse struct {
+	Adverts []AdvertResponse `json:"adverts"`
+	Next    string           `json:"next,omitempty"`
+}
@@ -0,0 +1,11 @@
+package model_api
+
+// CategoryFullResponse is the full view of a category, it adds the audit metadata to CategoryResponse
+type CategoryFullResponse struct {
+	CategoryResponse
+	Version          int16  `json:"version"`
+	CreatedBy        string `json:"createdBy"`
+	CreationDate     string `json:"creationDate"`
+	ModifiedBy       string `json:"modifiedBy"`
+	LastModifiedDate string `json:"lastModifiedDate"`
+}
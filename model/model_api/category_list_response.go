@@ -0,0 +1,6 @@
+package model_api
+
+type CategoryListResponse struct {
+	Categories []CategoryResponse `json:"categories"`
+	MissingIds []int64            `json:"missingIds"`
+}
@@ -3,4 +3,7 @@ package model_api
 type CategoryResponse struct {
 	Id   int64  `json:"id"`
 	Name string `json:"name"`
+	// Version and LastModifiedDate are not serialized, they are used for conditional requests
+	Version          int16  `json:"-"`
+	LastModifiedDate string `json:"-"`
 }
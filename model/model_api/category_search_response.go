@@ -0,0 +1,8 @@
+package model_api
+
+type CategorySearchResponse struct {
+	Categories []CategoryResponse `json:"categories"`
+	Page       int                `json:"page"`
+	Size       int                `json:"size"`
+	TotalCount int64              `json:"totalCount"`
+}
@@ -0,0 +1,13 @@
+package model_api
+
+type CategoryTreeResponse struct {
+	Id       int64                   `json:"id"`
+	Name     string                  `json:"name"`
+	ParentId int64                   `json:"parentId"`
+	Depth    int                     `json:"depth"`
+	Children []*CategoryTreeResponse `json:"children"`
+}
+
+type CategoryAncestorsResponse struct {
+	Ancestors []CategoryResponse `json:"ancestors"`
+}
@@ -0,0 +1,5 @@
+package model_api
+
+type SimilarAdvertsResponse struct {
+	Adverts []AdvertResponse `json:"adverts"`
+}
@@ -0,0 +1,11 @@
+package model_api
+
+type SuggestResponse struct {
+	Suggestions []SuggestionResponse `json:"suggestions"`
+}
+
+type SuggestionResponse struct {
+	Text  string  `json:"text"`
+	Type  string  `json:"type"`
+	Score float64 `json:"score"`
+}
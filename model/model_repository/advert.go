@@ -6,18 +6,31 @@ type Advert struct {
 	Description      string         `json:"description"`
 	Version          int16          `json:"version"`
 	Category         AdvertCategory `json:"category"`
+	Location         *GeoPoint      `json:"location,omitempty"`
+	City             string         `json:"city"`
+	District         string         `json:"district"`
 	CreatedBy        string         `json:"createdBy"`
 	CreationDate     string         `json:"creationDate"`
 	ModifiedBy       string         `json:"modifiedBy"`
 	LastModifiedDate string         `json:"lastModifiedDate"`
+	// Deleted marks a tombstone, the writer keeps the id, version and lastModifiedDate of a deleted advert.
+	// The change feed returns tombstones, the id reads treat them as not found
+	Deleted bool `json:"deleted,omitempty"`
 }
 
 type AdvertCategory struct {
-	Id               int64  `json:"id"`
-	Name             string `json:"name"`
-	Version          int16  `json:"version"`
-	CreatedBy        string `json:"createdBy"`
-	CreationDate     string `json:"creationDate"`
-	ModifiedBy       string `json:"modifiedBy"`
-	LastModifiedDate string `json:"lastModifiedDate"`
+	Id               int64             `json:"id"`
+	Name             string            `json:"name"`
+	Names            map[string]string `json:"names,omitempty"`
+	Version          int16             `json:"version"`
+	CreatedBy        string            `json:"createdBy"`
+	CreationDate     string            `json:"creationDate"`
+	ModifiedBy       string            `json:"modifiedBy"`
+	LastModifiedDate string            `json:"lastModifiedDate"`
+}
+
+// GeoPoint is indexed as a geo_point
+type GeoPoint struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
 }
@@ -0,0 +1,13 @@
+package model_repository
+
+type AdvertCursorResult struct {
+	Adverts    []*Advert
+	NextCursor string
+}
+
+// AdvertChangeResult is a page of the change feed, Checkpoint resumes the feed after its last change
+type AdvertChangeResult struct {
+	Adverts    []*Advert
+	Checkpoint string
+	HasMore    bool
+}
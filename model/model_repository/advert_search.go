@@ -0,0 +1,60 @@
+package model_repository
+
+type AdvertFacet string
+
+const (
+	AdvertFacetCategory      AdvertFacet = "category"
+	AdvertFacetCreationMonth AdvertFacet = "creationMonth"
+)
+
+type CountRelation string
+
+const (
+	CountRelationExact      CountRelation = "exact"
+	CountRelationLowerBound CountRelation = "lowerBound"
+)
+
+// AdvertFilter is shared by the search and count of adverts, dates are inclusive
+type AdvertFilter struct {
+	Text             string
+	CategoryId       int64
+	CreationDateFrom string
+	CreationDateTo   string
+	Ranges           []RangeFilter
+	Near             *GeoDistance
+}
+
+// GeoDistance is the origin of distance calculations, adverts farther than RadiusKm are filtered out when it is set
+type GeoDistance struct {
+	Origin   GeoPoint
+	RadiusKm float64
+}
+
+type AdvertSearchCriteria struct {
+	AdvertFilter
+	Facets         []AdvertFacet
+	Fields         []string
+	SortByDistance bool
+	From           int
+	Size           int
+}
+
+type AdvertSearchResult struct {
+	Adverts []*Advert
+	Facets  map[AdvertFacet][]FacetBucket
+	// DistancesKm holds the distance of each advert to the filter origin by advert id, it is empty without an origin
+	DistancesKm map[int64]float64
+	TotalCount  int64
+}
+
+type AdvertCount struct {
+	Count    int64
+	Relation CountRelation
+}
+
+type FacetBucket struct {
+	Key   string
+	Name  string
+	Names map[string]string
+	Count int64
+}
@@ -0,0 +1,23 @@
+package model_repository
+
+type CategorySortField string
+
+const (
+	CategorySortById   CategorySortField = "id"
+	CategorySortByName CategorySortField = "name"
+)
+
+type CategorySearchCriteria struct {
+	NamePrefix string
+	Ranges     []RangeFilter
+	SortField  CategorySortField
+	Descending bool
+	Fields     []string
+	From       int
+	Size       int
+}
+
+type CategorySearchResult struct {
+	Categories []*Category
+	TotalCount int64
+}
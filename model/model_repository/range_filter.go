@@ -0,0 +1,17 @@
+package model_repository
+
+type RangeOperator string
+
+const (
+	RangeOperatorGte RangeOperator = "gte"
+	RangeOperatorGt  RangeOperator = "gt"
+	RangeOperatorLte RangeOperator = "lte"
+	RangeOperatorLt  RangeOperator = "lt"
+)
+
+// RangeFilter compares Field with Value, every filter must match so filters on the same field narrow the range
+type RangeFilter struct {
+	Field    string
+	Operator RangeOperator
+	Value    string
+}
@@ -0,0 +1,6 @@
+package model_repository
+
+type Suggestion struct {
+	Text  string
+	Score float64
+}